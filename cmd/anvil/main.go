@@ -1,12 +1,28 @@
 package main
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
+	"time"
 
-
+	"anvil/internal/aptos"
+	"anvil/internal/bitcoin"
+	"anvil/internal/cosmos"
 	"anvil/internal/crypto"
+	"anvil/internal/ethereum"
+	"anvil/internal/hardware"
+	"anvil/internal/near"
+	"anvil/internal/solana"
+	"anvil/internal/sui"
+	"anvil/internal/tron"
+	"anvil/pkg/coins"
+	"anvil/pkg/keystore"
+	"anvil/pkg/signer"
+	"anvil/pkg/types"
 	"anvil/pkg/wallet"
 	"github.com/spf13/cobra"
 )
@@ -14,7 +30,7 @@ import (
 const version = "0.1.0"
 
 var (
-	version = "dev" // Set via build flags
+	version   = "dev" // Set via build flags
 	buildDate = "unknown"
 	gitCommit = "unknown"
 )
@@ -53,7 +69,7 @@ accounts for multiple cryptocurrencies.`,
 	},
 }
 
-// deriveCmd represents the derive command  
+// deriveCmd represents the derive command
 var deriveCmd = &cobra.Command{
 	Use:   "derive",
 	Short: "Derive a specific account from a mnemonic",
@@ -84,14 +100,39 @@ func init() {
 	generateCmd.Flags().StringVarP(&outputFile, "output", "o", "", "Output file for wallet data (default: stdout)")
 	generateCmd.Flags().BoolVar(&includePrivate, "include-private", false, "Include private keys in output (DANGEROUS)")
 	generateCmd.Flags().BoolVar(&includeMnemonic, "include-mnemonic", false, "Include mnemonic phrase in output (DANGEROUS)")
-	generateCmd.Flags().StringVar(&format, "format", "json", "Output format: json, text, paper, qr")
+	generateCmd.Flags().StringVar(&format, "format", "json", "Output format: json, text, paper, qr, keystore, watchonly, shares, psbt")
 	generateCmd.Flags().BoolVar(&paper, "paper", false, "Generate paper wallet format")
 	generateCmd.Flags().BoolVar(&qrCodes, "qr", false, "Generate QR codes")
+	generateCmd.Flags().StringVar(&keystoreDir, "keystore-dir", "", "Output directory for --format keystore")
+	generateCmd.Flags().StringVar(&keystorePassphraseFile, "keystore-passphrase-file", "", "File containing the keystore encryption passphrase")
+	generateCmd.Flags().BoolVar(&keystoreAllowEd25519, "keystore-allow-ed25519", false, "Emit ed25519 accounts (e.g. Solana) in an Anvil-specific keystore variant instead of skipping them")
+	generateCmd.Flags().StringVar(&watchOnlyFormat, "watchonly-format", "bitcoin-cli", "Watch-only script flavor for --format watchonly: bitcoin-cli, bitcoin-importwallet, descriptors")
+	generateCmd.Flags().StringVar(&seedFormat, "seed-format", "plain", "Mnemonic presentation when --include-mnemonic is set: plain, aezeed")
+	generateCmd.Flags().StringVar(&seedPassphraseFile, "seed-passphrase-file", "", "File containing the aezeed encryption passphrase (required for --seed-format aezeed)")
+	generateCmd.Flags().IntVar(&recoveryWindow, "recovery-window", 2500, "Number of external/internal addresses to expand per BTC/DOGE account for --format watchonly")
+	generateCmd.Flags().IntVar(&sharesThreshold, "shares-threshold", 2, "Number of shares required to reconstruct the wallet for --format shares")
+	generateCmd.Flags().IntVar(&sharesTotal, "shares-total", 3, "Total number of Shamir shares to generate for --format shares")
+	generateCmd.Flags().StringVar(&keyStoreBackend, "keystore", "", "External backend to push derived private keys into instead of keeping them in memory: file, pkcs11")
+	generateCmd.Flags().StringVar(&pkcs11Module, "pkcs11-module", "", "Path to the PKCS#11 module .so (required for --keystore pkcs11)")
+	generateCmd.Flags().StringVar(&pkcs11PIN, "pkcs11-pin", "", "User PIN for the PKCS#11 token (required for --keystore pkcs11)")
 
 	// Recover command flags
 	recoverCmd.Flags().StringVar(&mnemonic, "mnemonic", "", "BIP39 mnemonic phrase")
 	recoverCmd.Flags().StringVar(&passphrase, "passphrase", "", "Optional passphrase for seed derivation")
 	recoverCmd.Flags().StringVarP(&outputFile, "output", "o", "", "Output file for wallet data (default: stdout)")
+	recoverCmd.Flags().StringVar(&format, "format", "json", "Output format: json, text, paper, qr, keystore, watchonly, shares, psbt")
+	recoverCmd.Flags().StringVar(&keystoreDir, "keystore-dir", "", "Output directory for --format keystore")
+	recoverCmd.Flags().StringVar(&keystorePassphraseFile, "keystore-passphrase-file", "", "File containing the keystore encryption passphrase")
+	recoverCmd.Flags().BoolVar(&keystoreAllowEd25519, "keystore-allow-ed25519", false, "Emit ed25519 accounts in an Anvil-specific keystore variant instead of skipping them")
+	recoverCmd.Flags().StringVar(&watchOnlyFormat, "watchonly-format", "bitcoin-cli", "Watch-only script flavor for --format watchonly: bitcoin-cli, bitcoin-importwallet, descriptors")
+	recoverCmd.Flags().StringVar(&seedFormat, "seed-format", "plain", "Mnemonic presentation when --include-mnemonic is set: plain, aezeed")
+	recoverCmd.Flags().StringVar(&seedPassphraseFile, "seed-passphrase-file", "", "File containing the aezeed encryption passphrase (required for --seed-format aezeed)")
+	recoverCmd.Flags().IntVar(&recoveryWindow, "recovery-window", 2500, "Number of external/internal addresses to expand per BTC/DOGE account for --format watchonly")
+	recoverCmd.Flags().IntVar(&sharesThreshold, "shares-threshold", 2, "Number of shares required to reconstruct the wallet for --format shares")
+	recoverCmd.Flags().IntVar(&sharesTotal, "shares-total", 3, "Total number of Shamir shares to generate for --format shares")
+	recoverCmd.Flags().StringVar(&keyStoreBackend, "keystore", "", "External backend to push derived private keys into instead of keeping them in memory: file, pkcs11")
+	recoverCmd.Flags().StringVar(&pkcs11Module, "pkcs11-module", "", "Path to the PKCS#11 module .so (required for --keystore pkcs11)")
+	recoverCmd.Flags().StringVar(&pkcs11PIN, "pkcs11-pin", "", "User PIN for the PKCS#11 token (required for --keystore pkcs11)")
 
 	// Derive command flags
 	deriveCmd.Flags().StringVar(&mnemonic, "mnemonic", "", "BIP39 mnemonic phrase")
@@ -99,9 +140,12 @@ func init() {
 	deriveCmd.Flags().StringVar(&path, "path", "", "Derivation path (e.g., m/44'/0'/0'/0/0)")
 	deriveCmd.Flags().StringVar(&passphrase, "passphrase", "", "Optional passphrase for seed derivation")
 	deriveCmd.Flags().BoolVar(&includePrivate, "include-private", false, "Include private keys in output (DANGEROUS)")
-	deriveCmd.Flags().StringVar(&format, "format", "json", "Output format: json, text")
+	deriveCmd.Flags().StringVar(&format, "format", "json", "Output format: json, text, keystore")
 	deriveCmd.Flags().BoolVar(&qrCodes, "qr", false, "Generate QR codes")
 	deriveCmd.Flags().StringVarP(&outputFile, "output", "o", "", "Output file (default: stdout)")
+	deriveCmd.Flags().StringVar(&keystoreDir, "keystore-dir", "", "Output directory for --format keystore")
+	deriveCmd.Flags().StringVar(&keystorePassphraseFile, "keystore-passphrase-file", "", "File containing the keystore encryption passphrase")
+	deriveCmd.Flags().BoolVar(&keystoreAllowEd25519, "keystore-allow-ed25519", false, "Emit ed25519 accounts in an Anvil-specific keystore variant instead of skipping them")
 }
 
 func generateWallet() error {
@@ -164,10 +208,69 @@ func createWalletFromMnemonic(mnemonic, passphrase string) error {
 		wallet.CoinTypes[coin.Symbol()] = []uint32{getCoinType(coin)}
 	}
 
+	if err := pushToKeyStore(wallet); err != nil {
+		return err
+	}
+
 	// Output wallet data
 	return outputWallet(wallet)
 }
 
+// pushToKeyStore, when --keystore is set, stores each account's private key
+// in the selected backend and clears it from the in-memory wallet, so only
+// the output.Generator's usual public-material path can reach it from here
+// on - private keys never round-trip through anvil's own memory again after
+// this call returns.
+func pushToKeyStore(wallet *types.Wallet) error {
+	if keyStoreBackend == "" {
+		return nil
+	}
+
+	var store types.KeyStore
+	switch keyStoreBackend {
+	case "file":
+		if keystoreDir == "" {
+			return fmt.Errorf("--keystore-dir is required for --keystore file")
+		}
+		if keystorePassphraseFile == "" {
+			return fmt.Errorf("--keystore-passphrase-file is required for --keystore file")
+		}
+		data, err := os.ReadFile(keystorePassphraseFile)
+		if err != nil {
+			return fmt.Errorf("failed to read keystore passphrase file: %w", err)
+		}
+		store = keystore.NewFileKeyStore(keystoreDir, strings.TrimSpace(string(data)))
+	case "pkcs11":
+		if pkcs11Module == "" {
+			return fmt.Errorf("--pkcs11-module is required for --keystore pkcs11")
+		}
+		if pkcs11PIN == "" {
+			return fmt.Errorf("--pkcs11-pin is required for --keystore pkcs11")
+		}
+		token, err := keystore.OpenPKCS11KeyStore(pkcs11Module, pkcs11PIN)
+		if err != nil {
+			return fmt.Errorf("failed to open PKCS#11 token: %w", err)
+		}
+		defer token.Close()
+		store = token
+	default:
+		return fmt.Errorf("unsupported keystore backend: %s", keyStoreBackend)
+	}
+
+	for i, account := range wallet.Accounts {
+		if len(account.PrivateKey) == 0 {
+			continue
+		}
+		if err := store.Store(account); err != nil {
+			return fmt.Errorf("failed to push %s account %s to keystore: %w", account.Symbol, account.Address, err)
+		}
+		crypto.ClearBytes(wallet.Accounts[i].PrivateKey)
+		wallet.Accounts[i].PrivateKey = nil
+	}
+
+	return nil
+}
+
 func deriveAccount(mnemonic, coinType, path string) error {
 	// Convert mnemonic to seed
 	seed, err := crypto.MnemonicToSeed(mnemonic, passphrase)
@@ -216,17 +319,59 @@ func outputWallet(wallet *types.Wallet) error {
 			includeMnemonic = true
 		case "qr":
 			outputFormat = types.OutputQR
+		case "keystore":
+			outputFormat = types.OutputKeystore
+		case "watchonly":
+			outputFormat = types.OutputWatchOnly
+		case "shares":
+			outputFormat = types.OutputShares
+			includeMnemonic = true // Shares are derived from the wallet's entropy
+		case "psbt":
+			outputFormat = types.OutputPSBT
 		default:
 			return fmt.Errorf("unsupported format: %s", format)
 		}
 	}
 
+	var keystorePassphrase string
+	if outputFormat == types.OutputKeystore {
+		if keystorePassphraseFile == "" {
+			return fmt.Errorf("--keystore-passphrase-file is required for --format keystore")
+		}
+		data, err := os.ReadFile(keystorePassphraseFile)
+		if err != nil {
+			return fmt.Errorf("failed to read keystore passphrase file: %w", err)
+		}
+		keystorePassphrase = strings.TrimSpace(string(data))
+	}
+
+	var seedPassphrase string
+	if seedFormat != "" && seedFormat != "plain" {
+		if seedPassphraseFile == "" {
+			return fmt.Errorf("--seed-passphrase-file is required for --seed-format %s", seedFormat)
+		}
+		data, err := os.ReadFile(seedPassphraseFile)
+		if err != nil {
+			return fmt.Errorf("failed to read seed passphrase file: %w", err)
+		}
+		seedPassphrase = strings.TrimSpace(string(data))
+	}
+
 	// Create output options
 	options := types.OutputOptions{
-		Format:          outputFormat,
-		IncludePrivate:  includePrivate,
-		IncludeMnemonic: includeMnemonic,
-		FilePath:        outputFile,
+		Format:               outputFormat,
+		IncludePrivate:       includePrivate,
+		IncludeMnemonic:      includeMnemonic,
+		FilePath:             outputFile,
+		KeystoreDir:          keystoreDir,
+		KeystorePassphrase:   keystorePassphrase,
+		KeystoreAllowEd25519: keystoreAllowEd25519,
+		WatchOnlyFormat:      watchOnlyFormat,
+		RecoveryWindow:       recoveryWindow,
+		SeedFormat:           seedFormat,
+		SeedPassphrase:       seedPassphrase,
+		ShareThreshold:       sharesThreshold,
+		ShareTotal:           sharesTotal,
 	}
 
 	// Validate options and show warnings
@@ -277,6 +422,10 @@ func getCoinType(coin types.Coin) uint32 {
 		return c.GetCoinType()
 	case *tron.TronCoin:
 		return c.GetCoinType()
+	case *solana.SolanaCoin:
+		return c.GetCoinType()
+	case *cosmos.CosmosCoin:
+		return c.GetCoinType()
 	default:
 		return 0
 	}
@@ -286,6 +435,8 @@ func getCoinInstance(coinType string) types.Coin {
 	switch coinType {
 	case "BTC":
 		return bitcoin.NewBitcoin()
+	case "BTCTEST":
+		return bitcoin.NewBitcoinTestnet()
 	case "ETH":
 		return ethereum.NewEthereum()
 	case "DOGE":
@@ -294,16 +445,60 @@ func getCoinInstance(coinType string) types.Coin {
 		return ethereum.NewBinanceCoin()
 	case "TRX":
 		return tron.NewTron()
+	case "SOL":
+		return solana.NewSolana()
+	case "APT":
+		return aptos.NewAptos()
+	case "SUI":
+		return sui.NewSui()
+	case "NEAR":
+		return near.NewNear()
+	case "ATOM":
+		return cosmos.NewCosmos()
+	case "OSMO":
+		return cosmos.NewOsmosis()
+	case "INJ":
+		return cosmos.NewInjective()
+	case "LUNA":
+		return cosmos.NewTerra()
 	default:
 		return nil
 	}
 }
 
+// registerCoinDrivers populates coins.DefaultRegistry with every chain this
+// CLI supports, so the "anvil coins" command can enumerate them generically
+// instead of keeping its own hard-coded list alongside getCoinInstance.
+func registerCoinDrivers() {
+	coins.Register("BTC", bitcoin.NewBitcoin())
+	coins.Register("BTCTEST", bitcoin.NewBitcoinTestnet())
+	coins.Register("DOGE", bitcoin.NewDogecoin())
+	coins.Register("ETH", ethereum.NewEthereum())
+	coins.Register("BNB", ethereum.NewBinanceCoin())
+	coins.Register("TRX", tron.NewTron())
+	coins.Register("SOL", solana.NewSolana())
+	coins.Register("APT", aptos.NewAptos())
+	coins.Register("SUI", sui.NewSui())
+	coins.Register("NEAR", near.NewNear())
+	coins.Register("ATOM", cosmos.NewCosmos())
+	coins.Register("OSMO", cosmos.NewOsmosis())
+	coins.Register("INJ", cosmos.NewInjective())
+	coins.Register("LUNA", cosmos.NewTerra())
+
+	// Additional EVM-compatible chains (Polygon, Avalanche C-Chain, ...),
+	// data-driven from evmchains.json rather than a dedicated constructor
+	// each. ETH/BNB keep the lines above since they predate that table.
+	if err := ethereum.RegisterEVMCoins(); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to register EVM chain table: %v\n", err)
+	}
+}
 
 func init() {
 	// Initialize secure runtime on startup
 	crypto.InitSecureRuntime()
 
+	registerCoinDrivers()
+
 	// Check for security warnings
 	if warnings := crypto.VerifySecureEnvironment(); len(warnings) > 0 {
 		fmt.Fprintf(os.Stderr, "⚠️  SECURITY WARNINGS:\n")
@@ -321,7 +516,7 @@ func main() {
 
 // Additional CLI flags for output formatting
 var (
-	version = "dev" // Set via build flags
+	version   = "dev" // Set via build flags
 	buildDate = "unknown"
 	gitCommit = "unknown"
 )
@@ -343,3 +538,569 @@ var versionCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(versionCmd)
 }
+
+// hwCmd groups the hardware-wallet subcommands under `anvil hw`.
+var hwCmd = &cobra.Command{
+	Use:   "hw",
+	Short: "Interact with a connected Ledger hardware wallet",
+	Long:  `Enumerate connected Ledger devices and derive addresses without ever exposing a seed.`,
+}
+
+// hwListCmd represents `anvil hw list`.
+var hwListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List connected Ledger devices",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return listHardwareDevices()
+	},
+}
+
+// hwDeriveCmd represents `anvil hw derive`.
+var hwDeriveCmd = &cobra.Command{
+	Use:   "derive",
+	Short: "Derive an address on a connected Ledger device",
+	Long: `Derive a public key/address for the given coin and path directly on a
+connected Ledger device. The seed never leaves the device.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if hwCoin == "" {
+			return fmt.Errorf("coin is required (BTC, ETH, TRX, or SOL)")
+		}
+		if hwPath == "" {
+			return fmt.Errorf("derivation path is required")
+		}
+		return deriveHardwareAddress(hwCoin, hwPath)
+	},
+}
+
+var (
+	hwCoin string
+	hwPath string
+)
+
+// Keystore output flags, shared by generate/recover/derive.
+var (
+	keystoreDir            string
+	keystorePassphraseFile string
+	keystoreAllowEd25519   bool
+)
+
+// Watch-only output flags, shared by generate/recover.
+var (
+	watchOnlyFormat string
+	recoveryWindow  int
+)
+
+// Seed output flags, shared by generate/recover.
+var (
+	seedFormat         string
+	seedPassphraseFile string
+)
+
+// Shamir share output flags, shared by generate/recover.
+var (
+	sharesThreshold int
+	sharesTotal     int
+)
+
+// External key storage backend flags, shared by generate/recover. When
+// keyStoreBackend is set, derived private keys are pushed into that backend
+// and cleared from the in-memory wallet before output, so the
+// output.Generator only ever sees public material.
+var (
+	keyStoreBackend string
+	pkcs11Module    string
+	pkcs11PIN       string
+)
+
+func init() {
+	rootCmd.AddCommand(hwCmd)
+	hwCmd.AddCommand(hwListCmd)
+	hwCmd.AddCommand(hwDeriveCmd)
+
+	hwDeriveCmd.Flags().StringVar(&hwCoin, "coin", "", "Coin app to use (BTC, ETH, TRX, SOL)")
+	hwDeriveCmd.Flags().StringVar(&hwPath, "path", "", "Derivation path (e.g., m/44'/60'/0'/0/0)")
+}
+
+// signCmd represents `anvil sign`, the offline transaction signing flow.
+var signCmd = &cobra.Command{
+	Use:   "sign",
+	Short: "Sign an unsigned transaction offline",
+	Long: `Consume an unsigned transaction blob for BTC, ETH, TRX, or SOL, derive
+the requested path from a mnemonic entered on this (ideally airgapped)
+machine, and write back a signed transaction. The input format (hex, base64,
+or PSBT) is auto-detected.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if signCoin == "" {
+			return fmt.Errorf("coin is required (BTC, ETH, TRX, or SOL)")
+		}
+		if signPath == "" {
+			return fmt.Errorf("derivation path is required")
+		}
+		if signInFile == "" {
+			return fmt.Errorf("--in is required")
+		}
+		return runSign(signCoin, signPath, signInFile, signOutFile, signMnemonicFile)
+	},
+}
+
+var (
+	signCoin         string
+	signPath         string
+	signInFile       string
+	signOutFile      string
+	signMnemonicFile string
+)
+
+func init() {
+	rootCmd.AddCommand(signCmd)
+
+	signCmd.Flags().StringVar(&signCoin, "coin", "", "Coin to sign for (BTC, ETH, TRX, SOL)")
+	signCmd.Flags().StringVar(&signPath, "path", "", "Derivation path (e.g., m/44'/501'/0'/0')")
+	signCmd.Flags().StringVar(&signInFile, "in", "", "Path to the unsigned transaction blob")
+	signCmd.Flags().StringVar(&signOutFile, "out", "", "Path to write the signed transaction (default: stdout)")
+	signCmd.Flags().StringVar(&signMnemonicFile, "mnemonic-file", "", "File containing the BIP39 mnemonic phrase")
+	signCmd.Flags().StringVar(&passphrase, "passphrase", "", "Optional passphrase for seed derivation")
+}
+
+func runSign(coinSymbol, path, inFile, outFile, mnemonicFile string) error {
+	if mnemonicFile == "" {
+		return fmt.Errorf("--mnemonic-file is required")
+	}
+	mnemonicBytes, err := os.ReadFile(mnemonicFile)
+	if err != nil {
+		return fmt.Errorf("failed to read mnemonic file: %w", err)
+	}
+	mnemonicPhrase := strings.TrimSpace(string(mnemonicBytes))
+
+	seed, err := crypto.MnemonicToSeed(mnemonicPhrase, passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to convert mnemonic to seed: %w", err)
+	}
+	defer crypto.ClearBytes(seed)
+
+	coin := getCoinInstance(coinSymbol)
+	if coin == nil {
+		return fmt.Errorf("unsupported coin: %s", coinSymbol)
+	}
+
+	rawInput, err := os.ReadFile(inFile)
+	if err != nil {
+		return fmt.Errorf("failed to read input transaction: %w", err)
+	}
+
+	rawTx, format, err := signer.DecodeInput(string(rawInput))
+	if err != nil {
+		return fmt.Errorf("failed to decode input transaction: %w", err)
+	}
+
+	signed, err := signer.Sign(coin, seed, path, rawTx, nil)
+	if err != nil {
+		return err
+	}
+
+	encoded := signer.EncodeOutput(signed, format)
+	if outFile == "" {
+		fmt.Println(encoded)
+		return nil
+	}
+	return os.WriteFile(outFile, []byte(encoded), 0600)
+}
+
+// scanCmd represents `anvil scan`, batch address discovery over a Bip44Range.
+var scanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "Derive a range of addresses for gap-limit style discovery",
+	Long: `Batch-derive a contiguous range of accounts/addresses from a structured
+BIP44 path instead of the fixed 3-path default, so wallets can scan for used
+addresses the way block explorers and recovery tools expect.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if mnemonic == "" {
+			return fmt.Errorf("mnemonic phrase is required")
+		}
+		if scanCoin == "" {
+			return fmt.Errorf("coin is required (BTC, ETH, TRX, or SOL)")
+		}
+		if scanCount <= 0 {
+			return fmt.Errorf("count must be positive")
+		}
+		return scanAccounts(mnemonic, scanCoin, uint32(scanAccount), uint32(scanStart), uint32(scanCount))
+	},
+}
+
+var (
+	scanCoin    string
+	scanAccount int
+	scanStart   int
+	scanCount   int
+)
+
+func init() {
+	rootCmd.AddCommand(scanCmd)
+
+	scanCmd.Flags().StringVar(&mnemonic, "mnemonic", "", "BIP39 mnemonic phrase")
+	scanCmd.Flags().StringVar(&passphrase, "passphrase", "", "Optional passphrase for seed derivation")
+	scanCmd.Flags().StringVar(&scanCoin, "coin", "", "Coin to scan (BTC, ETH, TRX, SOL)")
+	scanCmd.Flags().IntVar(&scanAccount, "account", 0, "Base account index")
+	scanCmd.Flags().IntVar(&scanStart, "start", 0, "First index in the range to derive")
+	scanCmd.Flags().IntVar(&scanCount, "count", 20, "Number of addresses to derive")
+	scanCmd.Flags().StringVarP(&outputFile, "output", "o", "", "Output file for wallet data (default: stdout)")
+	scanCmd.Flags().StringVar(&format, "format", "json", "Output format: json, text")
+}
+
+// scanAccounts batch-derives a Bip44Range of addresses for coinSymbol, the
+// way `anvil scan --coin SOL --account 0 --count 20` discovers a gap-limit
+// worth of addresses instead of the 3 hardcoded paths GetStandardDerivationPaths
+// returns.
+func scanAccounts(mnemonicPhrase, coinSymbol string, account, start, count uint32) error {
+	seed, err := crypto.MnemonicToSeed(mnemonicPhrase, passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to convert mnemonic to seed: %w", err)
+	}
+	defer crypto.ClearBytes(seed)
+
+	coin := getCoinInstance(coinSymbol)
+	if coin == nil {
+		return fmt.Errorf("unsupported coin: %s", coinSymbol)
+	}
+	typed, ok := coin.(types.TypedDeriver)
+	if !ok {
+		return fmt.Errorf("%s does not support typed BIP44 derivation", coinSymbol)
+	}
+
+	hardened := uint8(3)
+	if coinSymbol == "SOL" {
+		hardened = 4
+	}
+	base := types.Bip44{Coin: getCoinType(coin), Account: account, HardenedComponents: hardened}
+
+	accounts := make([]types.Account, 0, count)
+	for _, p := range (types.Bip44Range{StartIndex: start, Count: count}).Expand(base) {
+		acc, err := typed.DeriveAccountTyped(seed, p)
+		if err != nil {
+			return fmt.Errorf("failed to derive %s: %w", p.ToPath(), err)
+		}
+		accounts = append(accounts, acc)
+	}
+
+	wallet := &types.Wallet{
+		Accounts: accounts,
+		Version:  version,
+	}
+	return outputWallet(wallet)
+}
+
+// vanityCmd represents `anvil vanity`, a brute-force vanity address search.
+var vanityCmd = &cobra.Command{
+	Use:   "vanity",
+	Short: "Search for an address starting with a given prefix",
+	Long: `Generate random keypairs until one's address starts with the requested
+prefix. Currently only TRX supports vanity search. The expected number of
+attempts grows as 58^len(prefix), so keep prefixes short.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if vanityCoin == "" {
+			return fmt.Errorf("coin is required (currently only TRX is supported)")
+		}
+		if vanityPrefix == "" {
+			return fmt.Errorf("prefix is required")
+		}
+		return runVanitySearch(vanityCoin, vanityPrefix, vanityWorkers, vanityTimeout)
+	},
+}
+
+var (
+	vanityCoin    string
+	vanityPrefix  string
+	vanityWorkers int
+	vanityTimeout time.Duration
+)
+
+func init() {
+	rootCmd.AddCommand(vanityCmd)
+
+	vanityCmd.Flags().StringVar(&vanityCoin, "coin", "TRX", "Coin to search (currently only TRX)")
+	vanityCmd.Flags().StringVar(&vanityPrefix, "prefix", "", "Address prefix to search for (after the mandatory 'T')")
+	vanityCmd.Flags().IntVar(&vanityWorkers, "workers", runtime.NumCPU(), "Number of parallel search workers")
+	vanityCmd.Flags().DurationVar(&vanityTimeout, "timeout", 0, "Give up after this long (0 = no timeout)")
+}
+
+func runVanitySearch(coinSymbol, prefix string, workers int, timeout time.Duration) error {
+	generator, ok := getCoinInstance(coinSymbol).(types.VanityGenerator)
+	if !ok {
+		return fmt.Errorf("%s does not support vanity address generation", coinSymbol)
+	}
+
+	cost := tron.EstimateVanityCost(prefix)
+	fmt.Fprintf(os.Stderr, "Searching for prefix %q (expected ~%.0f attempts) with %d workers...\n", prefix, cost.ExpectedAttempts, workers)
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	account, err := generator.GenerateVanityAddress(ctx, prefix, workers)
+	if err != nil {
+		return fmt.Errorf("vanity search failed: %w", err)
+	}
+
+	wallet := &types.Wallet{
+		Accounts: []types.Account{*account},
+		Version:  version,
+	}
+	return outputWallet(wallet)
+}
+
+// messageCmd groups the personal-message signing subcommands under
+// `anvil message`, kept separate from `anvil sign`/`anvil recover` (which
+// sign raw transactions and recover wallets, respectively) to avoid
+// overloading those names.
+var messageCmd = &cobra.Command{
+	Use:   "message",
+	Short: "Sign, verify, and recover EIP-191/TIP-191 personal messages",
+}
+
+// messageSignCmd represents `anvil message sign`.
+var messageSignCmd = &cobra.Command{
+	Use:   "sign",
+	Short: "Sign a message with a private key or derived account",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMessageSign(msgCoin, msgText, msgPrivateKeyHex, msgMnemonic, msgPath)
+	},
+}
+
+// messageVerifyCmd represents `anvil message verify`.
+var messageVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify a message signature against an address",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMessageVerify(msgCoin, msgText, msgAddress, msgSigHex)
+	},
+}
+
+// messageRecoverCmd represents `anvil message recover`.
+var messageRecoverCmd = &cobra.Command{
+	Use:   "recover",
+	Short: "Recover the signing address from a message signature",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMessageRecover(msgCoin, msgText, msgSigHex)
+	},
+}
+
+var (
+	msgCoin          string
+	msgText          string
+	msgPrivateKeyHex string
+	msgMnemonic      string
+	msgPath          string
+	msgAddress       string
+	msgSigHex        string
+)
+
+func init() {
+	rootCmd.AddCommand(messageCmd)
+	messageCmd.AddCommand(messageSignCmd)
+	messageCmd.AddCommand(messageVerifyCmd)
+	messageCmd.AddCommand(messageRecoverCmd)
+
+	messageCmd.PersistentFlags().StringVar(&msgCoin, "coin", "ETH", "Coin whose message convention to use (ETH, BNB, TRX)")
+	messageCmd.PersistentFlags().StringVar(&msgText, "message", "", "Message text to sign/verify/recover")
+
+	messageSignCmd.Flags().StringVar(&msgPrivateKeyHex, "private-key", "", "Hex-encoded private key to sign with")
+	messageSignCmd.Flags().StringVar(&msgMnemonic, "mnemonic", "", "BIP39 mnemonic phrase (alternative to --private-key)")
+	messageSignCmd.Flags().StringVar(&msgPath, "path", "", "Derivation path to use with --mnemonic (e.g., m/44'/60'/0'/0/0)")
+
+	messageVerifyCmd.Flags().StringVar(&msgAddress, "address", "", "Address the signature is claimed to be from")
+	messageVerifyCmd.Flags().StringVar(&msgSigHex, "signature", "", "Hex-encoded signature")
+
+	messageRecoverCmd.Flags().StringVar(&msgSigHex, "signature", "", "Hex-encoded signature")
+}
+
+// messageSigner resolves coinSymbol to its types.Signer implementation.
+func messageSigner(coinSymbol string) (types.Signer, error) {
+	signer, ok := getCoinInstance(coinSymbol).(types.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%s does not support message signing", coinSymbol)
+	}
+	return signer, nil
+}
+
+func runMessageSign(coinSymbol, message, privateKeyHex, mnemonicPhrase, path string) error {
+	if message == "" {
+		return fmt.Errorf("--message is required")
+	}
+
+	signer, err := messageSigner(coinSymbol)
+	if err != nil {
+		return err
+	}
+
+	var privateKey []byte
+	switch {
+	case privateKeyHex != "":
+		privateKey, err = hex.DecodeString(strings.TrimPrefix(privateKeyHex, "0x"))
+		if err != nil {
+			return fmt.Errorf("failed to decode private key: %w", err)
+		}
+	case mnemonicPhrase != "":
+		if path == "" {
+			return fmt.Errorf("--path is required with --mnemonic")
+		}
+		seed, err := crypto.MnemonicToSeed(mnemonicPhrase, passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to convert mnemonic to seed: %w", err)
+		}
+		defer crypto.ClearBytes(seed)
+
+		account, err := getCoinInstance(coinSymbol).DeriveAccount(seed, path)
+		if err != nil {
+			return fmt.Errorf("failed to derive account: %w", err)
+		}
+		privateKey = account.PrivateKey
+	default:
+		return fmt.Errorf("either --private-key or --mnemonic/--path is required")
+	}
+
+	sig, err := signer.Sign(privateKey, []byte(message))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(hex.EncodeToString(sig))
+	return nil
+}
+
+func runMessageVerify(coinSymbol, message, address, sigHex string) error {
+	if message == "" || address == "" || sigHex == "" {
+		return fmt.Errorf("--message, --address, and --signature are all required")
+	}
+
+	signer, err := messageSigner(coinSymbol)
+	if err != nil {
+		return err
+	}
+
+	sig, err := hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+	if err != nil {
+		return fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	valid, err := signer.Verify(address, []byte(message), sig)
+	if err != nil {
+		return err
+	}
+
+	if valid {
+		fmt.Println("valid")
+	} else {
+		fmt.Println("invalid")
+	}
+	return nil
+}
+
+func runMessageRecover(coinSymbol, message, sigHex string) error {
+	if message == "" || sigHex == "" {
+		return fmt.Errorf("--message and --signature are required")
+	}
+
+	signer, err := messageSigner(coinSymbol)
+	if err != nil {
+		return err
+	}
+
+	sig, err := hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+	if err != nil {
+		return fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	address, err := signer.RecoverAddress([]byte(message), sig)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(address)
+	return nil
+}
+
+func listHardwareDevices() error {
+	devices, err := hardware.ListDevices()
+	if err != nil {
+		return fmt.Errorf("failed to list hardware devices: %w", err)
+	}
+
+	if len(devices) == 0 {
+		fmt.Println("No Ledger devices found")
+		return nil
+	}
+
+	for _, d := range devices {
+		fmt.Printf("%s (serial: %s) at %s\n", d.Product, d.SerialNumber, d.Path)
+	}
+	return nil
+}
+
+func deriveHardwareAddress(coin, path string) error {
+	device, err := hardware.Open(hardware.Coin(coin))
+	if err != nil {
+		return fmt.Errorf("failed to open ledger: %w", err)
+	}
+	defer device.Close()
+
+	address, publicKey, err := device.DeriveAddress(path)
+	if err != nil {
+		return fmt.Errorf("failed to derive address: %w", err)
+	}
+
+	fmt.Printf("Coin:       %s\n", coin)
+	fmt.Printf("Path:       %s\n", path)
+	fmt.Printf("Address:    %s\n", address)
+	fmt.Printf("Public Key: %x\n", publicKey)
+	return nil
+}
+
+// coinsCmd groups coin-registry subcommands under `anvil coins`.
+var coinsCmd = &cobra.Command{
+	Use:   "coins",
+	Short: "Inspect the registered coin drivers",
+	Long:  `List every chain supported by the coins.Registry and the capabilities each one implements.`,
+}
+
+// coinsListCmd represents `anvil coins list`.
+var coinsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List every registered coin and its capabilities",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return listRegisteredCoins()
+	},
+}
+
+func init() {
+	coinsCmd.AddCommand(coinsListCmd)
+	rootCmd.AddCommand(coinsCmd)
+}
+
+// listRegisteredCoins prints every coin in coins.DefaultRegistry along with
+// the optional capability interfaces (types.Signer, types.VanityGenerator,
+// types.HexConverter) it implements, discovered via type assertion rather
+// than a hard-coded per-coin list.
+func listRegisteredCoins() error {
+	for _, driver := range coins.All() {
+		var capabilities []string
+		if _, ok := driver.(types.Signer); ok {
+			capabilities = append(capabilities, "message-signing")
+		}
+		if _, ok := driver.(types.VanityGenerator); ok {
+			capabilities = append(capabilities, "vanity")
+		}
+		if _, ok := driver.(types.HexConverter); ok {
+			capabilities = append(capabilities, "hex-address")
+		}
+
+		fmt.Printf("%-6s %-16s curve=%-10s coinType=%-6d", driver.Symbol(), driver.Name(), driver.Curve(), driver.GetCoinType())
+		if len(capabilities) > 0 {
+			fmt.Printf(" capabilities=%s", strings.Join(capabilities, ","))
+		}
+		fmt.Println()
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Package aptos implements the types.Coin interface for Aptos, deriving
+// ed25519 keys via SLIP-0010 (see internal/crypto.DeriveKeyEd25519) since
+// Aptos, like Solana, has no secp256k1 BIP32 derivation to reuse.
+package aptos
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"anvil/internal/crypto"
+	"anvil/pkg/coins"
+	"anvil/pkg/types"
+	"golang.org/x/crypto/sha3"
+)
+
+// singleEd25519Scheme is Aptos's authentication key scheme identifier for a
+// single ed25519 key, appended to the public key before hashing.
+const singleEd25519Scheme byte = 0x00
+
+// AptosCoin implements the types.Coin interface for Aptos
+type AptosCoin struct {
+	name     string
+	symbol   string
+	coinType uint32
+}
+
+// NewAptos creates a new Aptos coin instance
+func NewAptos() *AptosCoin {
+	return &AptosCoin{
+		name:     "Aptos",
+		symbol:   "APT",
+		coinType: 637, // BIP44 coin type for Aptos
+	}
+}
+
+// Name returns the full name of the cryptocurrency
+func (a *AptosCoin) Name() string {
+	return a.name
+}
+
+// Symbol returns the symbol/ticker of the cryptocurrency
+func (a *AptosCoin) Symbol() string {
+	return a.symbol
+}
+
+// DeriveAccount derives a new account for the given seed and derivation path
+func (a *AptosCoin) DeriveAccount(seed []byte, path string) (types.Account, error) {
+	key, err := crypto.DeriveKeyEd25519(seed, path)
+	if err != nil {
+		return types.Account{}, fmt.Errorf("failed to derive ed25519 key: %w", err)
+	}
+
+	publicKey := key.PublicKey()
+	address := AddressFromPublicKey(publicKey)
+
+	account := types.Account{
+		Path:       path,
+		PrivateKey: key.PrivateKey(),
+		PublicKey:  publicKey,
+		Address:    address,
+		Symbol:     a.symbol,
+		CreatedAt:  time.Now(),
+	}
+
+	crypto.SecureZeroMemory(key.Key)
+
+	return account, nil
+}
+
+// AddressFromPublicKey derives an Aptos account address (authentication
+// key) from an ed25519 public key: sha3-256(publicKey || 0x00), hex-encoded
+// with a 0x prefix.
+func AddressFromPublicKey(publicKey ed25519.PublicKey) string {
+	hash := sha3.Sum256(append(append([]byte{}, publicKey...), singleEd25519Scheme))
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+// ValidateAddress checks if an address is a valid Aptos account address
+func (a *AptosCoin) ValidateAddress(address string) bool {
+	if len(address) != 66 || address[:2] != "0x" {
+		return false
+	}
+	_, err := hex.DecodeString(address[2:])
+	return err == nil
+}
+
+// GetStandardDerivationPaths returns common derivation paths for Aptos
+func (a *AptosCoin) GetStandardDerivationPaths() []string {
+	return []string{
+		fmt.Sprintf("m/44'/%d'/0'/0'/0'", a.coinType), // Standard Aptos path (hardened)
+		fmt.Sprintf("m/44'/%d'/1'/0'/0'", a.coinType), // Second account
+		fmt.Sprintf("m/44'/%d'/2'/0'/0'", a.coinType), // Third account
+	}
+}
+
+// Curve returns the elliptic curve Aptos derives keys on, for
+// coins.CoinDriver capability discovery.
+func (a *AptosCoin) Curve() coins.Curve {
+	return coins.CurveEd25519
+}
+
+// GetCoinType returns the BIP44 coin type for Aptos
+func (a *AptosCoin) GetCoinType() uint32 {
+	return a.coinType
+}
@@ -0,0 +1,79 @@
+package aptos
+
+import (
+	"strings"
+	"testing"
+
+	"anvil/internal/crypto"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestAptosAddressGeneration(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	apt := NewAptos()
+	account, err := apt.DeriveAccount(seed, "m/44'/637'/0'/0'/0'")
+	if err != nil {
+		t.Fatalf("DeriveAccount returned error: %v", err)
+	}
+
+	if !strings.HasPrefix(account.Address, "0x") || len(account.Address) != 66 {
+		t.Errorf("expected a 0x-prefixed 32-byte address, got %s", account.Address)
+	}
+	if account.Symbol != "APT" {
+		t.Errorf("expected symbol APT, got %s", account.Symbol)
+	}
+	if !apt.ValidateAddress(account.Address) {
+		t.Errorf("expected derived address %s to validate", account.Address)
+	}
+}
+
+func TestAptosDeriveAccountDeterministic(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	apt := NewAptos()
+	first, err := apt.DeriveAccount(seed, "m/44'/637'/0'/0'/0'")
+	if err != nil {
+		t.Fatalf("DeriveAccount returned error: %v", err)
+	}
+	second, err := apt.DeriveAccount(seed, "m/44'/637'/0'/0'/0'")
+	if err != nil {
+		t.Fatalf("DeriveAccount returned error: %v", err)
+	}
+
+	if first.Address != second.Address {
+		t.Errorf("expected deterministic derivation, got %s and %s", first.Address, second.Address)
+	}
+}
+
+func TestAptosValidateAddress(t *testing.T) {
+	if NewAptos().ValidateAddress("not-an-address") {
+		t.Error("expected an invalid address to fail validation")
+	}
+}
+
+func TestAptosGetStandardDerivationPaths(t *testing.T) {
+	paths := NewAptos().GetStandardDerivationPaths()
+	expected := []string{
+		"m/44'/637'/0'/0'/0'",
+		"m/44'/637'/1'/0'/0'",
+		"m/44'/637'/2'/0'/0'",
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected %d paths, got %d", len(expected), len(paths))
+	}
+	for i, p := range paths {
+		if p != expected[i] {
+			t.Errorf("paths[%d] = %s, want %s", i, p, expected[i])
+		}
+	}
+}
@@ -1,16 +1,170 @@
 package bitcoin
 
 import (
+	"encoding/binary"
 	"fmt"
+	"sync"
 	"time"
 
 	"anvil/internal/crypto"
+	"anvil/pkg/coins"
 	"anvil/pkg/types"
+	"anvil/pkg/xpub"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
 )
 
+// Purpose identifies the BIP44-style purpose field of a derivation path.
+// For Bitcoin-family coins it also selects the address encoding, the way
+// ecosystem wallets branch on it: 44' for legacy P2PKH, 49' for
+// P2SH-wrapped SegWit, 84' for native SegWit, 86' for Taproot.
+type Purpose uint32
+
+const (
+	PurposeLegacy       Purpose = 44 // P2PKH
+	PurposeNestedSegwit Purpose = 49 // P2SH-P2WPKH
+	PurposeNativeSegwit Purpose = 84 // native bech32 P2WPKH
+	PurposeTaproot      Purpose = 86 // Taproot P2TR
+)
+
+// PurposeLabel returns a short human-readable label for a purpose, for
+// output formats (paper wallet, text) that want to show callers which
+// address scheme an account uses alongside its derivation path.
+func (p Purpose) PurposeLabel() string {
+	switch p {
+	case PurposeLegacy:
+		return "Legacy (BIP44)"
+	case PurposeNestedSegwit:
+		return "SegWit (BIP49)"
+	case PurposeNativeSegwit:
+		return "Native SegWit (BIP84)"
+	case PurposeTaproot:
+		return "Taproot (BIP86)"
+	default:
+		return ""
+	}
+}
+
+// AddressEncoder turns a compressed secp256k1 public key into an address
+// string for a particular purpose and network.
+type AddressEncoder func(publicKeyBytes []byte, netParams *chaincfg.Params) (string, error)
+
+// purposeCoinKey identifies one (purpose, coin_type) pair in a CoinRegistry.
+type purposeCoinKey struct {
+	purpose  Purpose
+	coinType uint32
+}
+
+// CoinRegistry maps a (purpose, coin_type) pair to the AddressEncoder that
+// purpose implies for that coin. It's keyed by coin_type as well as purpose
+// - rather than purpose alone - so a coin with nonstandard address rules
+// could one day override a specific purpose's encoding without affecting
+// every other Bitcoin-family coin. This is distinct from pkg/coins.Registry,
+// which is keyed by chain (name/coin_type) and has no notion of purpose.
+type CoinRegistry struct {
+	mu       sync.RWMutex
+	encoders map[purposeCoinKey]AddressEncoder
+}
+
+// NewCoinRegistry creates an empty purpose/coin-type registry.
+func NewCoinRegistry() *CoinRegistry {
+	return &CoinRegistry{
+		encoders: make(map[purposeCoinKey]AddressEncoder),
+	}
+}
+
+// Register adds encoder for the given purpose and coin type, overwriting
+// any previous registration - coin constructors call this for every purpose
+// they support, so re-registering on repeated construction (e.g. in tests)
+// is expected rather than an error.
+func (r *CoinRegistry) Register(purpose Purpose, coinType uint32, encoder AddressEncoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.encoders[purposeCoinKey{purpose, coinType}] = encoder
+}
+
+// Lookup finds the AddressEncoder registered for purpose and coinType. It
+// reports false if nothing is registered for that exact pair; there is no
+// wildcard fallback across coin types.
+func (r *CoinRegistry) Lookup(purpose Purpose, coinType uint32) (AddressEncoder, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	encoder, ok := r.encoders[purposeCoinKey{purpose, coinType}]
+	return encoder, ok
+}
+
+// DefaultCoinRegistry is the registry populated by BitcoinCoin constructors
+// (NewBitcoin, NewDogecoin, ...) as they run.
+var DefaultCoinRegistry = NewCoinRegistry()
+
+// registerStandardPurposes registers the four standard purpose encoders for
+// coinType against DefaultCoinRegistry. Every Bitcoin-family coin in this
+// package supports the same four address schemes; only netParams (supplied
+// at encode time, not baked into the encoder) differs between them.
+func registerStandardPurposes(coinType uint32) {
+	DefaultCoinRegistry.Register(PurposeLegacy, coinType, addressP2PKH)
+	DefaultCoinRegistry.Register(PurposeNestedSegwit, coinType, addressP2SHSegwit)
+	DefaultCoinRegistry.Register(PurposeNativeSegwit, coinType, addressP2WPKH)
+	DefaultCoinRegistry.Register(PurposeTaproot, coinType, addressP2TR)
+}
+
+// addressP2PKH encodes a legacy pay-to-pubkey-hash address (BIP44).
+func addressP2PKH(publicKeyBytes []byte, netParams *chaincfg.Params) (string, error) {
+	return AddressFromPublicKey(publicKeyBytes, netParams)
+}
+
+// addressP2WPKH encodes a native SegWit bech32 pay-to-witness-pubkey-hash
+// address (BIP84).
+func addressP2WPKH(publicKeyBytes []byte, netParams *chaincfg.Params) (string, error) {
+	pubKeyHash := btcutil.Hash160(publicKeyBytes)
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, netParams)
+	if err != nil {
+		return "", fmt.Errorf("failed to create address: %w", err)
+	}
+	return addr.EncodeAddress(), nil
+}
+
+// addressP2SHSegwit encodes a P2SH-wrapped SegWit address (BIP49): a
+// P2WPKH witness program nested inside a P2SH script, for wallets that
+// predate native bech32 support.
+func addressP2SHSegwit(publicKeyBytes []byte, netParams *chaincfg.Params) (string, error) {
+	pubKeyHash := btcutil.Hash160(publicKeyBytes)
+	witnessProg, err := btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, netParams)
+	if err != nil {
+		return "", fmt.Errorf("failed to create witness program: %w", err)
+	}
+
+	script, err := txscript.PayToAddrScript(witnessProg)
+	if err != nil {
+		return "", fmt.Errorf("failed to build witness script: %w", err)
+	}
+
+	addr, err := btcutil.NewAddressScriptHash(script, netParams)
+	if err != nil {
+		return "", fmt.Errorf("failed to create address: %w", err)
+	}
+	return addr.EncodeAddress(), nil
+}
+
+// addressP2TR encodes a Taproot pay-to-taproot address (BIP86), tweaking
+// the internal key with an empty script tree (key-path spend only - this
+// repo doesn't construct script-path Taproot outputs).
+func addressP2TR(publicKeyBytes []byte, netParams *chaincfg.Params) (string, error) {
+	pubKey, err := btcec.ParsePubKey(publicKeyBytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	tapKey := txscript.ComputeTaprootKeyNoScript(pubKey)
+	addr, err := btcutil.NewAddressTaproot(tapKey.SerializeCompressed()[1:], netParams)
+	if err != nil {
+		return "", fmt.Errorf("failed to create address: %w", err)
+	}
+	return addr.EncodeAddress(), nil
+}
+
 // BitcoinCoin implements the types.Coin interface for Bitcoin
 type BitcoinCoin struct {
 	name      string
@@ -21,10 +175,12 @@ type BitcoinCoin struct {
 
 // NewBitcoin creates a new Bitcoin coin instance
 func NewBitcoin() *BitcoinCoin {
+	coinType := uint32(0) // BIP44 coin type for Bitcoin
+	registerStandardPurposes(coinType)
 	return &BitcoinCoin{
 		name:      "Bitcoin",
 		symbol:    "BTC",
-		coinType:  0, // BIP44 coin type for Bitcoin
+		coinType:  coinType,
 		netParams: &chaincfg.MainNetParams,
 	}
 }
@@ -38,14 +194,30 @@ func NewDogecoin() *BitcoinCoin {
 	dogecoinParams.PubKeyHashAddrID = 0x1E // Dogecoin addresses start with 'D'
 	dogecoinParams.ScriptHashAddrID = 0x16 // P2SH addresses start with '9' or 'A'
 
+	coinType := uint32(3) // BIP44 coin type for Dogecoin
+	registerStandardPurposes(coinType)
 	return &BitcoinCoin{
 		name:      "Dogecoin",
 		symbol:    "DOGE",
-		coinType:  3, // BIP44 coin type for Dogecoin
+		coinType:  coinType,
 		netParams: &dogecoinParams,
 	}
 }
 
+// NewBitcoinTestnet creates a Bitcoin coin instance for testnet3, using
+// BIP44 coin type 1 - the reserved "any testnet" coin type shared by every
+// coin's test network, per SLIP-0044 - instead of Bitcoin mainnet's 0.
+func NewBitcoinTestnet() *BitcoinCoin {
+	coinType := uint32(1) // SLIP-0044 testnet coin type
+	registerStandardPurposes(coinType)
+	return &BitcoinCoin{
+		name:      "Bitcoin Testnet",
+		symbol:    "tBTC",
+		coinType:  coinType,
+		netParams: &chaincfg.TestNet3Params,
+	}
+}
+
 // Name returns the full name of the cryptocurrency
 func (b *BitcoinCoin) Name() string {
 	return b.name
@@ -71,8 +243,9 @@ func (b *BitcoinCoin) DeriveAccount(seed []byte, path string) (types.Account, er
 	privateKey, publicKey := btcec.PrivKeyFromBytes(privateKeyBytes)
 	publicKeyBytes := publicKey.SerializeCompressed()
 
-	// Generate address from public key
-	address, err := b.publicKeyToAddress(publicKeyBytes)
+	// Generate address from public key, encoded according to the path's
+	// purpose (legacy, SegWit, Taproot, ...).
+	address, err := b.publicKeyToAddress(publicKeyBytes, path)
 	if err != nil {
 		return types.Account{}, fmt.Errorf("failed to generate address: %w", err)
 	}
@@ -92,11 +265,55 @@ func (b *BitcoinCoin) DeriveAccount(seed []byte, path string) (types.Account, er
 	return account, nil
 }
 
-// publicKeyToAddress converts a compressed public key to a Bitcoin address
-func (b *BitcoinCoin) publicKeyToAddress(publicKeyBytes []byte) (string, error) {
-	// Create address from compressed public key
+// publicKeyToAddress converts a compressed public key to a Bitcoin address,
+// choosing the encoding (P2PKH, P2SH-P2WPKH, P2WPKH, P2TR) that path's
+// purpose component implies via DefaultCoinRegistry. A path whose purpose
+// isn't registered for this coin type (nonstandard paths, hardware-wallet
+// apps) falls back to legacy P2PKH, matching this coin's original behavior.
+func (b *BitcoinCoin) publicKeyToAddress(publicKeyBytes []byte, path string) (string, error) {
+	purpose, err := PathPurpose(path)
+	if err == nil {
+		if encoder, ok := DefaultCoinRegistry.Lookup(purpose, b.coinType); ok {
+			return encoder(publicKeyBytes, b.netParams)
+		}
+	}
+	return AddressFromPublicKey(publicKeyBytes, b.netParams)
+}
+
+// AddressForPath derives the address for publicKeyBytes using the encoding
+// path's purpose implies, exactly as DeriveAccount does. It's exported for
+// callers that already hold a derived public key and a path (e.g.
+// watch-only address expansion, which derives keys directly via
+// crypto.DeriveKey rather than through DeriveAccount) and need the same
+// purpose-aware encoding without re-deriving the account.
+func (b *BitcoinCoin) AddressForPath(publicKeyBytes []byte, path string) (string, error) {
+	return b.publicKeyToAddress(publicKeyBytes, path)
+}
+
+// PathPurpose extracts the purpose (the first, always-hardened component)
+// from a derivation path string such as "m/84'/0'/0'/0/0". Exported so
+// other packages that need to branch on purpose (e.g. choosing a
+// watch-only descriptor's output script function) use the same parsing
+// this package derives addresses and xpubs from, rather than a second
+// copy of it.
+func PathPurpose(path string) (Purpose, error) {
+	derivePath, err := crypto.ParseDerivationPath(path)
+	if err != nil {
+		return 0, err
+	}
+	if len(derivePath.Components) == 0 {
+		return 0, fmt.Errorf("empty derivation path")
+	}
+	return Purpose(derivePath.Components[0].Value), nil
+}
+
+// AddressFromPublicKey converts a compressed secp256k1 public key to a
+// legacy P2PKH address for the given network. It is exported at package
+// level so hardware-wallet-derived public keys (see internal/hardware)
+// produce the same address as seed-derived accounts.
+func AddressFromPublicKey(publicKeyBytes []byte, netParams *chaincfg.Params) (string, error) {
 	pubKeyHash := btcutil.Hash160(publicKeyBytes)
-	addr, err := btcutil.NewAddressPubKeyHash(pubKeyHash, b.netParams)
+	addr, err := btcutil.NewAddressPubKeyHash(pubKeyHash, netParams)
 	if err != nil {
 		return "", fmt.Errorf("failed to create address: %w", err)
 	}
@@ -120,17 +337,98 @@ func (b *BitcoinCoin) ValidateAddress(address string) bool {
 	return err == nil
 }
 
-// GetStandardDerivationPaths returns common derivation paths for this coin
+// GetStandardDerivationPaths returns common derivation paths for this coin,
+// one per purpose this package supports an address encoding for. Only the
+// BIP44 path is expressible as a types.Bip44 (which is fixed to purpose
+// 44'); BIP49/84/86 use a different purpose field entirely, so they stay as
+// literal paths.
 func (b *BitcoinCoin) GetStandardDerivationPaths() []string {
 	coinType := b.coinType
+	bip44 := types.Bip44{Coin: coinType, HardenedComponents: 3}
 	return []string{
-		fmt.Sprintf("m/44'/%d'/0'/0/0", coinType), // BIP44 (Legacy)
+		bip44.ToPath(), // BIP44 (Legacy)
 		fmt.Sprintf("m/49'/%d'/0'/0/0", coinType), // BIP49 (P2SH-P2WPKH)
 		fmt.Sprintf("m/84'/%d'/0'/0/0", coinType), // BIP84 (Native SegWit)
+		fmt.Sprintf("m/86'/%d'/0'/0/0", coinType), // BIP86 (Taproot)
 	}
 }
 
+// DeriveAccountTyped derives a new account from a structured Bip44 path,
+// rather than a free-form path string. Since Bip44 is fixed to purpose 44',
+// it cannot express BIP49/BIP84 paths - use DeriveAccount with a literal
+// path string for those. See DeriveAccount.
+func (b *BitcoinCoin) DeriveAccountTyped(seed []byte, p types.Bip44) (types.Account, error) {
+	return b.DeriveAccount(seed, p.ToPath())
+}
+
 // GetCoinType returns the BIP44 coin type for this cryptocurrency
 func (b *BitcoinCoin) GetCoinType() uint32 {
 	return b.coinType
 }
+
+// Curve returns the elliptic curve Bitcoin derives keys on, for
+// coins.CoinDriver capability discovery.
+func (b *BitcoinCoin) Curve() coins.Curve {
+	return coins.CurveSecp256k1
+}
+
+// GetNetParams returns the chain parameters this coin instance encodes
+// addresses for (mainnet Bitcoin, Dogecoin, etc).
+func (b *BitcoinCoin) GetNetParams() *chaincfg.Params {
+	return b.netParams
+}
+
+// DeriveXpub derives the account-level extended public key for accountPath
+// (e.g. "m/84'/0'/0'") and returns it Base58Check-encoded. The version byte
+// prefix follows the path's purpose - 44' yields an "xpub", 49' a "ypub",
+// 84' a "zpub" - so watch-only tools can tell which address type to derive
+// from the key without inspecting the path itself. Watch-only tools can
+// then derive the external/internal address chains from this xpub alone,
+// without ever seeing the seed.
+func (b *BitcoinCoin) DeriveXpub(seed []byte, accountPath string) (string, error) {
+	version := xpub.VersionXpub
+	if purpose, err := PathPurpose(accountPath); err == nil {
+		version = xpub.VersionForPurpose(uint32(purpose))
+	}
+	return deriveXpub(seed, accountPath, version)
+}
+
+// DeriveDescriptorXpub derives the account-level extended public key for
+// accountPath, like DeriveXpub, but always under the plain "xpub" version
+// byte regardless of purpose. Bitcoin Core's descriptor wrappers
+// (pkh()/wpkh()/sh(wpkh())/tr()) convey the address type themselves and
+// only accept a plain xpub/tpub extended key - importdescriptors rejects
+// the purpose-versioned ypub/zpub that DeriveXpub returns for BIP49/84
+// accounts.
+func (b *BitcoinCoin) DeriveDescriptorXpub(seed []byte, accountPath string) (string, error) {
+	return deriveXpub(seed, accountPath, xpub.VersionXpub)
+}
+
+// deriveXpub derives the account-level extended public key for accountPath
+// and Base58Check-encodes it under the given version byte.
+func deriveXpub(seed []byte, accountPath string, version uint32) (string, error) {
+	accountKey, err := crypto.DeriveAccountKey(seed, accountPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to derive account key: %w", err)
+	}
+	neutered := accountKey.PublicKey()
+
+	fingerprint := binary.BigEndian.Uint32(neutered.FingerPrint)
+	childNumber := binary.BigEndian.Uint32(neutered.ChildNumber)
+	return xpub.Encode(version, neutered.Depth, fingerprint, childNumber, neutered.ChainCode, neutered.Key)
+}
+
+// MasterKeyFingerprint derives the wallet's master key fingerprint: the
+// first 4 bytes of Hash160 of the master public key, big-endian, per
+// BIP32. PSBTs (BIP174) embed this alongside an account xpub's
+// derivation path in their global xpub record, so watch-only wallets can
+// confirm which seed an imported xpub descends from.
+func MasterKeyFingerprint(seed []byte) (uint32, error) {
+	masterKey, err := crypto.DeriveMasterKey(seed)
+	if err != nil {
+		return 0, fmt.Errorf("failed to derive master key: %w", err)
+	}
+
+	pubKeyHash := btcutil.Hash160(masterKey.PublicKey().Key)
+	return binary.BigEndian.Uint32(pubKeyHash[:4]), nil
+}
@@ -31,11 +31,27 @@ var bitcoinTestVectors = []struct {
 		},
 	},
 	{
-		name:     "SegWit address",
+		name:     "Nested SegWit address (BIP49)",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		path:     "m/49'/0'/0'/0/0",
+		expected: map[string]string{
+			"BTC": "37VucYSaXLCAsxYyAPfbSi9eh4iEcbShgf",
+		},
+	},
+	{
+		name:     "Native SegWit address (BIP84)",
 		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
 		path:     "m/84'/0'/0'/0/0",
 		expected: map[string]string{
-			"BTC": "1JaUQDVNRdhfNsVncGkXedaPSM5Gc54Hso",
+			"BTC": "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu",
+		},
+	},
+	{
+		name:     "Taproot address (BIP86)",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		path:     "m/86'/0'/0'/0/0",
+		expected: map[string]string{
+			"BTC": "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr",
 		},
 	},
 }
@@ -52,7 +68,7 @@ func TestBitcoinAddressGeneration(t *testing.T) {
 
 			// Create Bitcoin coin instance
 			btc := NewBitcoin()
-			
+
 			// Derive account
 			account, err := btc.DeriveAccount(seed, tv.path)
 			if err != nil {
@@ -62,7 +78,7 @@ func TestBitcoinAddressGeneration(t *testing.T) {
 			// Check expected address
 			expectedAddr := tv.expected["BTC"]
 			if account.Address != expectedAddr {
-				t.Errorf("Address mismatch for %s:\nExpected: %s\nActual:   %s", 
+				t.Errorf("Address mismatch for %s:\nExpected: %s\nActual:   %s",
 					tv.path, expectedAddr, account.Address)
 			}
 
@@ -117,7 +133,7 @@ func TestDogecoinAddressGeneration(t *testing.T) {
 
 func TestBitcoinWIF(t *testing.T) {
 	btc := NewBitcoin()
-	
+
 	// Test with known private key
 	privateKeyHex := "0000000000000000000000000000000000000000000000000000000000000001"
 	privateKeyBytes, err := hex.DecodeString(privateKeyHex)
@@ -144,11 +160,12 @@ func TestBitcoinWIF(t *testing.T) {
 func TestBitcoinStandardPaths(t *testing.T) {
 	btc := NewBitcoin()
 	paths := btc.GetStandardDerivationPaths()
-	
+
 	expectedPaths := []string{
-		"m/44'/0'/0'/0/0",  // BIP44 Legacy
-		"m/49'/0'/0'/0/0",  // BIP49 P2SH-P2WPKH
-		"m/84'/0'/0'/0/0",  // BIP84 Native SegWit
+		"m/44'/0'/0'/0/0", // BIP44 Legacy
+		"m/49'/0'/0'/0/0", // BIP49 P2SH-P2WPKH
+		"m/84'/0'/0'/0/0", // BIP84 Native SegWit
+		"m/86'/0'/0'/0/0", // BIP86 Taproot
 	}
 
 	if len(paths) != len(expectedPaths) {
@@ -162,6 +179,94 @@ func TestBitcoinStandardPaths(t *testing.T) {
 	}
 }
 
+func TestCoinRegistryLookup(t *testing.T) {
+	// Constructing a Bitcoin coin registers its purposes as a side effect.
+	NewBitcoin()
+
+	for _, purpose := range []Purpose{PurposeLegacy, PurposeNestedSegwit, PurposeNativeSegwit, PurposeTaproot} {
+		if _, ok := DefaultCoinRegistry.Lookup(purpose, 0); !ok {
+			t.Errorf("expected purpose %d to be registered for coin type 0", purpose)
+		}
+	}
+
+	if _, ok := DefaultCoinRegistry.Lookup(Purpose(999), 0); ok {
+		t.Error("unregistered purpose should not be found")
+	}
+}
+
+func TestPurposeLabel(t *testing.T) {
+	testCases := []struct {
+		purpose  Purpose
+		expected string
+	}{
+		{PurposeLegacy, "Legacy (BIP44)"},
+		{PurposeNestedSegwit, "SegWit (BIP49)"},
+		{PurposeNativeSegwit, "Native SegWit (BIP84)"},
+		{PurposeTaproot, "Taproot (BIP86)"},
+		{Purpose(999), ""},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.purpose.PurposeLabel(); got != tc.expected {
+			t.Errorf("Purpose(%d).PurposeLabel() = %q, want %q", tc.purpose, got, tc.expected)
+		}
+	}
+}
+
+func TestBitcoinTestnetAddressGeneration(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	seed, err := crypto.MnemonicToSeed(mnemonic, "")
+	if err != nil {
+		t.Fatalf("Failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	btcTest := NewBitcoinTestnet()
+	account, err := btcTest.DeriveAccount(seed, "m/44'/1'/0'/0/0")
+	if err != nil {
+		t.Fatalf("Failed to derive testnet account: %v", err)
+	}
+
+	if !btcTest.ValidateAddress(account.Address) {
+		t.Errorf("Generated testnet address failed validation: %s", account.Address)
+	}
+
+	if btcTest.GetCoinType() != 1 {
+		t.Errorf("Bitcoin testnet coin type should be 1, got %d", btcTest.GetCoinType())
+	}
+}
+
+func TestDeriveXpubVersionMatchesPurpose(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	seed, err := crypto.MnemonicToSeed(mnemonic, "")
+	if err != nil {
+		t.Fatalf("Failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	btc := NewBitcoin()
+	testCases := []struct {
+		path   string
+		prefix string
+	}{
+		{"m/44'/0'/0'", "xpub"},
+		{"m/49'/0'/0'", "ypub"},
+		{"m/84'/0'/0'", "zpub"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.prefix, func(t *testing.T) {
+			key, err := btc.DeriveXpub(seed, tc.path)
+			if err != nil {
+				t.Fatalf("DeriveXpub(%s) failed: %v", tc.path, err)
+			}
+			if len(key) < len(tc.prefix) || key[:len(tc.prefix)] != tc.prefix {
+				t.Errorf("DeriveXpub(%s) = %s, want a %s-prefixed key", tc.path, key, tc.prefix)
+			}
+		})
+	}
+}
+
 func TestCoinType(t *testing.T) {
 	btc := NewBitcoin()
 	if btc.GetCoinType() != 0 {
@@ -179,7 +284,7 @@ func BenchmarkBitcoinAddressGeneration(b *testing.B) {
 	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
 	seed, _ := crypto.MnemonicToSeed(mnemonic, "")
 	defer crypto.SecureZeroMemory(seed)
-	
+
 	btc := NewBitcoin()
 	path := "m/44'/0'/0'/0/0"
 
@@ -0,0 +1,91 @@
+package bitcoin
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+)
+
+// SignRawTransaction finalizes a BIP174 PSBT v2 with a single derived
+// private key, covering the common case of a wallet signing every native
+// SegWit (P2WPKH) input it controls with one key. chainParams, if non-nil,
+// may be a *chaincfg.Params overriding this coin's own network parameters
+// (the default); signing itself does not depend on it. Implements
+// types.TransactionSigner.
+func (b *BitcoinCoin) SignRawTransaction(privKey []byte, rawTx []byte, chainParams any) ([]byte, error) {
+	params := b.netParams
+	if p, ok := chainParams.(*chaincfg.Params); ok && p != nil {
+		params = p
+	}
+
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader(rawTx), false)
+	if err != nil {
+		packet, err = psbt.NewFromRawBytes(bytes.NewReader(rawTx), true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PSBT: %w", err)
+		}
+	}
+
+	privateKey, pubKey := btcec.PrivKeyFromBytes(privKey)
+	fetcher := txscript.NewMultiPrevOutFetcher(nil)
+
+	for i, in := range packet.Inputs {
+		if in.WitnessUtxo == nil {
+			continue // non-witness inputs are out of scope for this offline signer
+		}
+		fetcher.AddPrevOut(packet.UnsignedTx.TxIn[i].PreviousOutPoint, in.WitnessUtxo)
+	}
+
+	sigHashes := txscript.NewTxSigHashes(packet.UnsignedTx, fetcher)
+
+	for i, in := range packet.Inputs {
+		if in.WitnessUtxo == nil {
+			continue
+		}
+
+		pkHash := btcutil.Hash160(pubKey.SerializeCompressed())
+		script, err := txscript.NewScriptBuilder().
+			AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).
+			AddData(pkHash).
+			AddOp(txscript.OP_EQUALVERIFY).AddOp(txscript.OP_CHECKSIG).
+			Script()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build signing script: %w", err)
+		}
+
+		sig, err := txscript.RawTxInWitnessSignature(
+			packet.UnsignedTx, sigHashes, i, in.WitnessUtxo.Value, script,
+			txscript.SigHashAll, privateKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign input %d: %w", i, err)
+		}
+
+		packet.Inputs[i].PartialSigs = append(packet.Inputs[i].PartialSigs, &psbt.PartialSig{
+			PubKey:    pubKey.SerializeCompressed(),
+			Signature: sig,
+		})
+
+		if err := psbt.Finalize(packet, i); err != nil {
+			return nil, fmt.Errorf("failed to finalize input %d: %w", i, err)
+		}
+	}
+
+	finalTx, err := psbt.Extract(packet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract final transaction: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := finalTx.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("failed to serialize signed transaction: %w", err)
+	}
+
+	_ = params // reserved for multi-network validation of the signed addresses
+	return buf.Bytes(), nil
+}
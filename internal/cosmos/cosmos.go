@@ -0,0 +1,164 @@
+// Package cosmos implements the types.Coin interface for Cosmos SDK chains.
+// These chains share Bitcoin's secp256k1 BIP32 derivation and Hash160 address
+// hash, but bech32-encode the result under a chain-specific human-readable
+// prefix (see internal/crypto/bech32) instead of Base58Check.
+package cosmos
+
+import (
+	"fmt"
+	"time"
+
+	"anvil/internal/crypto"
+	"anvil/internal/crypto/bech32"
+	"anvil/pkg/coins"
+	"anvil/pkg/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// CosmosCoin implements the types.Coin interface for a Cosmos SDK chain
+// identified by its bech32 human-readable prefix.
+type CosmosCoin struct {
+	name     string
+	symbol   string
+	coinType uint32
+	hrp      string
+}
+
+// NewCosmos creates a new Cosmos Hub (ATOM) coin instance.
+func NewCosmos() *CosmosCoin {
+	return &CosmosCoin{
+		name:     "Cosmos Hub",
+		symbol:   "ATOM",
+		coinType: 118, // BIP44 coin type for Cosmos
+		hrp:      "cosmos",
+	}
+}
+
+// NewOsmosis creates a new Osmosis (OSMO) coin instance.
+func NewOsmosis() *CosmosCoin {
+	return &CosmosCoin{
+		name:     "Osmosis",
+		symbol:   "OSMO",
+		coinType: 118, // Osmosis reuses the Cosmos Hub coin type
+		hrp:      "osmo",
+	}
+}
+
+// NewInjective creates a new Injective (INJ) coin instance. Injective, like
+// other Ethermint-based chains, derives keys at the Ethereum coin type rather
+// than the Cosmos one.
+func NewInjective() *CosmosCoin {
+	return &CosmosCoin{
+		name:     "Injective",
+		symbol:   "INJ",
+		coinType: 60, // Ethermint-style coin type shared with Ethereum
+		hrp:      "inj",
+	}
+}
+
+// NewTerra creates a new Terra (LUNA) coin instance.
+func NewTerra() *CosmosCoin {
+	return &CosmosCoin{
+		name:     "Terra",
+		symbol:   "LUNA",
+		coinType: 118, // BIP44 coin type for Terra
+		hrp:      "terra",
+	}
+}
+
+// Name returns the full name of the cryptocurrency
+func (c *CosmosCoin) Name() string {
+	return c.name
+}
+
+// Symbol returns the symbol/ticker of the cryptocurrency
+func (c *CosmosCoin) Symbol() string {
+	return c.symbol
+}
+
+// DeriveAccount derives a new account for the given seed and derivation path
+func (c *CosmosCoin) DeriveAccount(seed []byte, path string) (types.Account, error) {
+	key, err := crypto.DeriveKey(seed, path)
+	if err != nil {
+		return types.Account{}, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	privateKeyBytes := key.Key
+	_, publicKey := btcec.PrivKeyFromBytes(privateKeyBytes)
+	publicKeyBytes := publicKey.SerializeCompressed()
+
+	address, err := c.publicKeyToAddress(publicKeyBytes)
+	if err != nil {
+		return types.Account{}, fmt.Errorf("failed to generate address: %w", err)
+	}
+
+	account := types.Account{
+		Path:       path,
+		PrivateKey: append([]byte(nil), privateKeyBytes...),
+		PublicKey:  publicKeyBytes,
+		Address:    address,
+		Symbol:     c.symbol,
+		CreatedAt:  time.Now(),
+	}
+
+	crypto.SecureZeroMemory(privateKeyBytes)
+
+	return account, nil
+}
+
+// publicKeyToAddress converts a compressed public key to a bech32 address
+// under this coin's HRP.
+func (c *CosmosCoin) publicKeyToAddress(publicKeyBytes []byte) (string, error) {
+	return AddressFromPublicKey(publicKeyBytes, c.hrp)
+}
+
+// AddressFromPublicKey converts a compressed secp256k1 public key to a
+// bech32 address: RIPEMD160(SHA256(pubkey)) encoded under hrp. It is exported
+// at package level so hardware-wallet-derived public keys (see
+// internal/hardware) produce the same address as seed-derived accounts.
+func AddressFromPublicKey(publicKeyBytes []byte, hrp string) (string, error) {
+	pubKeyHash := btcutil.Hash160(publicKeyBytes)
+	address, err := bech32.Encode(hrp, pubKeyHash)
+	if err != nil {
+		return "", fmt.Errorf("failed to bech32-encode address: %w", err)
+	}
+	return address, nil
+}
+
+// ValidateAddress checks if an address is a valid bech32 address under this
+// coin's HRP.
+func (c *CosmosCoin) ValidateAddress(address string) bool {
+	hrp, data, err := bech32.Decode(address)
+	if err != nil {
+		return false
+	}
+	return hrp == c.hrp && len(data) == 20
+}
+
+// GetStandardDerivationPaths returns common derivation paths for this coin
+func (c *CosmosCoin) GetStandardDerivationPaths() []string {
+	base := types.Bip44{Coin: c.coinType, HardenedComponents: 3}
+	return []string{
+		base.ToPath(), // BIP44 standard path
+		types.Bip44Range{StartIndex: 1, Count: 1}.Expand(base)[0].ToPath(),        // Second address
+		types.Bip44{Coin: c.coinType, Account: 1, HardenedComponents: 3}.ToPath(), // Second account
+	}
+}
+
+// DeriveAccountTyped derives a new account from a structured Bip44 path,
+// rather than a free-form path string. See DeriveAccount.
+func (c *CosmosCoin) DeriveAccountTyped(seed []byte, p types.Bip44) (types.Account, error) {
+	return c.DeriveAccount(seed, p.ToPath())
+}
+
+// Curve returns the elliptic curve this coin derives keys on, for
+// coins.CoinDriver capability discovery.
+func (c *CosmosCoin) Curve() coins.Curve {
+	return coins.CurveSecp256k1
+}
+
+// GetCoinType returns the BIP44 coin type for this cryptocurrency
+func (c *CosmosCoin) GetCoinType() uint32 {
+	return c.coinType
+}
@@ -0,0 +1,160 @@
+package cosmos
+
+import (
+	"strings"
+	"testing"
+
+	"anvil/internal/crypto"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+// Test vectors for Cosmos-family address derivation
+var cosmosTestVectors = []struct {
+	name     string
+	coin     func() *CosmosCoin
+	path     string
+	expected string
+}{
+	{
+		name:     "Cosmos Hub",
+		coin:     NewCosmos,
+		path:     "m/44'/118'/0'/0/0",
+		expected: "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4",
+	},
+	{
+		name:     "Osmosis",
+		coin:     NewOsmosis,
+		path:     "m/44'/118'/0'/0/0",
+		expected: "osmo19rl4cm2hmr8afy4kldpxz3fka4jguq0a5m7df8",
+	},
+	{
+		name:     "Injective",
+		coin:     NewInjective,
+		path:     "m/44'/60'/0'/0/0",
+		expected: "inj1gsvdpdxec8hsu57lhxg5xem7refr233zkczfgv",
+	},
+	{
+		name:     "Terra",
+		coin:     NewTerra,
+		path:     "m/44'/118'/0'/0/0",
+		expected: "terra19rl4cm2hmr8afy4kldpxz3fka4jguq0a6yhaa4",
+	},
+}
+
+func TestCosmosAddressGeneration(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	for _, tv := range cosmosTestVectors {
+		t.Run(tv.name, func(t *testing.T) {
+			c := tv.coin()
+			account, err := c.DeriveAccount(seed, tv.path)
+			if err != nil {
+				t.Fatalf("failed to derive account: %v", err)
+			}
+
+			if account.Address != tv.expected {
+				t.Errorf("address mismatch for %s:\nexpected: %s\nactual:   %s", tv.path, tv.expected, account.Address)
+			}
+
+			if !c.ValidateAddress(account.Address) {
+				t.Errorf("generated address failed validation: %s", account.Address)
+			}
+
+			if !strings.HasPrefix(account.Address, c.hrp+"1") {
+				t.Errorf("address should start with %q, got: %s", c.hrp+"1", account.Address)
+			}
+
+			if account.Path != tv.path {
+				t.Errorf("path mismatch: expected %s, got %s", tv.path, account.Path)
+			}
+		})
+	}
+}
+
+func TestCosmosAddressValidation(t *testing.T) {
+	atom := NewCosmos()
+
+	validAddresses := []string{
+		"cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4",
+	}
+
+	for _, addr := range validAddresses {
+		if !atom.ValidateAddress(addr) {
+			t.Errorf("valid Cosmos address rejected: %s", addr)
+		}
+	}
+
+	invalidAddresses := []string{
+		"osmo19rl4cm2hmr8afy4kldpxz3fka4jguq0a5m7df8",  // wrong hrp
+		"cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdax", // bad checksum
+		"not-an-address",
+		"",
+	}
+
+	for _, addr := range invalidAddresses {
+		if atom.ValidateAddress(addr) {
+			t.Errorf("invalid Cosmos address accepted: %s", addr)
+		}
+	}
+}
+
+func TestCosmosStandardPaths(t *testing.T) {
+	atom := NewCosmos()
+	paths := atom.GetStandardDerivationPaths()
+
+	expectedPaths := []string{
+		"m/44'/118'/0'/0/0", // BIP44 standard path
+		"m/44'/118'/0'/0/1", // Second address
+		"m/44'/118'/1'/0/0", // Second account
+	}
+
+	if len(paths) != len(expectedPaths) {
+		t.Fatalf("expected %d standard paths, got %d", len(expectedPaths), len(paths))
+	}
+
+	for i, expectedPath := range expectedPaths {
+		if paths[i] != expectedPath {
+			t.Errorf("path %d mismatch: expected %s, got %s", i, expectedPath, paths[i])
+		}
+	}
+}
+
+func TestCosmosCoinType(t *testing.T) {
+	if NewCosmos().GetCoinType() != 118 {
+		t.Errorf("Cosmos coin type should be 118, got %d", NewCosmos().GetCoinType())
+	}
+	if NewInjective().GetCoinType() != 60 {
+		t.Errorf("Injective coin type should be 60, got %d", NewInjective().GetCoinType())
+	}
+}
+
+// Benchmark tests for performance
+func BenchmarkCosmosAddressGeneration(b *testing.B) {
+	seed, _ := crypto.MnemonicToSeed(testMnemonic, "")
+	defer crypto.SecureZeroMemory(seed)
+
+	atom := NewCosmos()
+	path := "m/44'/118'/0'/0/0"
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := atom.DeriveAccount(seed, path); err != nil {
+			b.Fatalf("failed to derive account: %v", err)
+		}
+	}
+}
+
+func BenchmarkCosmosAddressValidation(b *testing.B) {
+	atom := NewCosmos()
+	address := "cosmos19rl4cm2hmr8afy4kldpxz3fka4jguq0auqdal4"
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		atom.ValidateAddress(address)
+	}
+}
@@ -0,0 +1,248 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"strings"
+	"time"
+
+	"anvil/internal/crypto/bech32"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Package-level constants for the aezeed-style cipher seed, modeled after
+// lnd's walletunlocker aezeed format: a passphrase-encrypted mnemonic that
+// additionally commits to a recovery birthday, so wallet recovery can skip
+// chain history older than the seed itself.
+//
+// Unlike lnd, which encrypts with AEZ (a length-preserving wide-block
+// cipher with no Go implementation available to this module), we encrypt
+// with ChaCha20-Poly1305. That trades away AEZ's length-preserving property
+// for a mainstream, well-reviewed AEAD: the 16-byte Poly1305 tag makes our
+// cipher seed a 33-word mnemonic rather than lnd's 24, but the construction,
+// version byte, and pre-decryption checksum check are otherwise the same
+// shape.
+const (
+	// cipherSeedVersion is the outer wire-format version of the whole
+	// cipher seed blob (salt, ciphertext, checksum layout). Bump this if
+	// that layout ever changes.
+	cipherSeedVersion byte = 0
+
+	// cipherSeedInternalVersion is the version of the *plaintext* payload
+	// (birthday + entropy layout) once decrypted. It travels inside the
+	// authenticated ciphertext, separate from cipherSeedVersion, so it can
+	// evolve independently of the outer wire format.
+	cipherSeedInternalVersion byte = 0
+
+	cipherSeedSaltSize     = 5
+	cipherSeedChecksumSize = 4
+	// cipherSeedHeaderSize is the plaintext overhead before the entropy
+	// itself: a 1-byte internal version plus a 2-byte birthday.
+	cipherSeedHeaderSize = 1 + 2
+
+	// Scrypt parameters match lnd's own aezeed KDF: lighter than
+	// internal/output's keystore.go parameters (scryptN=1<<18) since this
+	// key is derived on every interactive wallet unlock, not just once at
+	// keystore-export time.
+	cipherSeedScryptN     = 1 << 15 // 32768
+	cipherSeedScryptR     = 8
+	cipherSeedScryptP     = 1
+	cipherSeedScryptDKLen = 32
+)
+
+// cipherSeedBirthdayEpoch is the reference date birthdays are counted from,
+// stored as a 2-byte count of days. Chosen to post-date Anvil's existence so
+// the 16-bit day count won't roll over for centuries.
+var cipherSeedBirthdayEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// GenerateCipherSeed encrypts entropy and birthday under passphrase into an
+// aezeed-style mnemonic: a passphrase-protected alternative to a plain BIP39
+// mnemonic that also commits to a recovery birthday. entropy must be a valid
+// BIP39 entropy length (16, 20, 24, 28, or 32 bytes) so it can double as the
+// entropy backing an existing plain mnemonic (see
+// internal/output.Generator's aezeed wiring). An empty passphrase is allowed
+// (matching MnemonicToSeed's handling of plain BIP39 mnemonics) but produces
+// a seed anyone can decrypt.
+func GenerateCipherSeed(entropy []byte, passphrase []byte, birthday time.Time) (string, error) {
+	if len(entropy)%4 != 0 || len(entropy) < 16 || len(entropy) > 32 {
+		return "", fmt.Errorf("entropy must be 16, 20, 24, 28, or 32 bytes, got %d", len(entropy))
+	}
+
+	days := birthday.UTC().Sub(cipherSeedBirthdayEpoch).Hours() / 24
+	if days < 0 || days > 0xFFFF {
+		return "", fmt.Errorf("birthday out of range: must be within 65535 days of %s", cipherSeedBirthdayEpoch.Format("2006-01-02"))
+	}
+
+	plaintext := make([]byte, cipherSeedHeaderSize+len(entropy))
+	plaintext[0] = cipherSeedInternalVersion
+	binary.BigEndian.PutUint16(plaintext[1:3], uint16(days))
+	copy(plaintext[3:], entropy)
+	defer ClearBytes(plaintext)
+
+	salt, err := SecureRandom(cipherSeedSaltSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	aead, key, err := newCipherSeedAEAD(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+	defer ClearBytes(key)
+
+	blob := make([]byte, 0, 1+cipherSeedSaltSize+len(plaintext)+aead.Overhead()+cipherSeedChecksumSize)
+	blob = append(blob, cipherSeedVersion)
+	blob = append(blob, salt...)
+	blob = aead.Seal(blob, cipherSeedNonce(salt), plaintext, blob[:1])
+
+	checksum := crc32.ChecksumIEEE(blob)
+	checksumBytes := make([]byte, cipherSeedChecksumSize)
+	binary.BigEndian.PutUint32(checksumBytes, checksum)
+	blob = append(blob, checksumBytes...)
+
+	return cipherSeedToMnemonic(blob)
+}
+
+// DecipherSeed reverses GenerateCipherSeed, returning the original entropy
+// and recovery birthday. It rejects a mnemonic whose checksum does not match
+// before ever attempting decryption, so a mistyped or corrupted mnemonic
+// fails fast instead of surfacing as a confusing decryption error.
+func DecipherSeed(mnemonic string, passphrase []byte) ([]byte, time.Time, error) {
+	blob, err := mnemonicToCipherSeed(mnemonic)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("invalid cipher seed mnemonic: %w", err)
+	}
+
+	overhead := 1 + cipherSeedSaltSize + cipherSeedHeaderSize + chacha20poly1305.Overhead + cipherSeedChecksumSize
+	entropyLen := len(blob) - overhead
+	if entropyLen%4 != 0 || entropyLen < 16 || entropyLen > 32 {
+		return nil, time.Time{}, fmt.Errorf("invalid cipher seed length: %d bytes", len(blob))
+	}
+
+	payload, gotChecksum := blob[:len(blob)-cipherSeedChecksumSize], blob[len(blob)-cipherSeedChecksumSize:]
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(gotChecksum) {
+		return nil, time.Time{}, fmt.Errorf("cipher seed checksum mismatch (mistyped or corrupted mnemonic)")
+	}
+
+	version := payload[0]
+	if version != cipherSeedVersion {
+		return nil, time.Time{}, fmt.Errorf("unsupported cipher seed version %d", version)
+	}
+	salt := payload[1 : 1+cipherSeedSaltSize]
+	ciphertext := payload[1+cipherSeedSaltSize:]
+
+	aead, key, err := newCipherSeedAEAD(passphrase, salt)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	defer ClearBytes(key)
+
+	plaintext, err := aead.Open(nil, cipherSeedNonce(salt), ciphertext, payload[:1])
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to decrypt cipher seed (wrong passphrase?): %w", err)
+	}
+	defer ClearBytes(plaintext)
+
+	if plaintext[0] != cipherSeedInternalVersion {
+		return nil, time.Time{}, fmt.Errorf("unsupported cipher seed internal version %d", plaintext[0])
+	}
+
+	days := binary.BigEndian.Uint16(plaintext[1:3])
+	birthday := cipherSeedBirthdayEpoch.Add(time.Duration(days) * 24 * time.Hour)
+
+	entropy := make([]byte, entropyLen)
+	copy(entropy, plaintext[3:])
+
+	return entropy, birthday, nil
+}
+
+// ChangePassphrase re-encrypts a cipher seed mnemonic under a new
+// passphrase, preserving its entropy and recovery birthday.
+func ChangePassphrase(mnemonic string, oldPassphrase, newPassphrase []byte) (string, error) {
+	entropy, birthday, err := DecipherSeed(mnemonic, oldPassphrase)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt with old passphrase: %w", err)
+	}
+	defer ClearBytes(entropy)
+
+	return GenerateCipherSeed(entropy, newPassphrase, birthday)
+}
+
+// newCipherSeedAEAD derives a ChaCha20-Poly1305 AEAD from passphrase and
+// salt via scrypt. It returns the derived key alongside the AEAD so callers
+// can wipe it once decryption/encryption completes.
+func newCipherSeedAEAD(passphrase, salt []byte) (aeadCipher, []byte, error) {
+	key, err := scrypt.Key(passphrase, salt, cipherSeedScryptN, cipherSeedScryptR, cipherSeedScryptP, cipherSeedScryptDKLen)
+	if err != nil {
+		return nil, nil, fmt.Errorf("scrypt key derivation failed: %w", err)
+	}
+
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		ClearBytes(key)
+		return nil, nil, fmt.Errorf("failed to initialize cipher: %w", err)
+	}
+
+	return aead, key, nil
+}
+
+// aeadCipher is the subset of cipher.AEAD this file relies on.
+type aeadCipher interface {
+	Seal(dst, nonce, plaintext, additionalData []byte) []byte
+	Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error)
+	Overhead() int
+}
+
+// cipherSeedNonce derives a ChaCha20-Poly1305 nonce deterministically from
+// salt, rather than storing a separate nonce: salt is fresh randomness on
+// every GenerateCipherSeed call, so the (key, nonce) pair is never reused.
+func cipherSeedNonce(salt []byte) []byte {
+	sum := sha256.Sum256(salt)
+	return sum[:chacha20poly1305.NonceSize]
+}
+
+// cipherSeedToMnemonic encodes an arbitrary byte blob into words drawn from
+// the BIP39 wordlist, 11 bits per word (2048 = 2^11 words), reusing
+// bech32.ConvertBits the same way bech32 itself regroups 8-bit bytes into
+// 5-bit symbols.
+func cipherSeedToMnemonic(blob []byte) (string, error) {
+	groups, err := bech32.ConvertBits(blob, 8, 11, true)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cipher seed: %w", err)
+	}
+
+	wordList := bip39.GetWordList()
+	words := make([]string, len(groups))
+	for i, g := range groups {
+		words[i] = wordList[g]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// mnemonicToCipherSeed reverses cipherSeedToMnemonic.
+func mnemonicToCipherSeed(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	indices := make([]int, len(words))
+	for i, w := range words {
+		idx, ok := bip39.GetWordIndex(w)
+		if !ok {
+			return nil, fmt.Errorf("word %q is not in the BIP39 wordlist", w)
+		}
+		indices[i] = idx
+	}
+
+	groups, err := bech32.ConvertBits(indices, 11, 8, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cipher seed words: %w", err)
+	}
+
+	out := make([]byte, len(groups))
+	for i, g := range groups {
+		out[i] = byte(g)
+	}
+	return out, nil
+}
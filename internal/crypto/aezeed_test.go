@@ -0,0 +1,169 @@
+package crypto
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func testEntropy() []byte {
+	return []byte{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+	}
+}
+
+func TestCipherSeedRoundTrip(t *testing.T) {
+	entropy := testEntropy()
+	birthday := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	mnemonic, err := GenerateCipherSeed(entropy, []byte("correct horse battery staple"), birthday)
+	if err != nil {
+		t.Fatalf("GenerateCipherSeed failed: %v", err)
+	}
+	if len(strings.Fields(mnemonic)) == 0 {
+		t.Fatal("expected a non-empty mnemonic")
+	}
+
+	gotEntropy, gotBirthday, err := DecipherSeed(mnemonic, []byte("correct horse battery staple"))
+	if err != nil {
+		t.Fatalf("DecipherSeed failed: %v", err)
+	}
+	if string(gotEntropy) != string(entropy) {
+		t.Errorf("entropy mismatch: got %x, want %x", gotEntropy, entropy)
+	}
+	if !gotBirthday.Equal(birthday) {
+		t.Errorf("birthday mismatch: got %v, want %v", gotBirthday, birthday)
+	}
+}
+
+func TestCipherSeedEmptyPassphrase(t *testing.T) {
+	entropy := testEntropy()
+	mnemonic, err := GenerateCipherSeed(entropy, nil, cipherSeedBirthdayEpoch)
+	if err != nil {
+		t.Fatalf("GenerateCipherSeed failed: %v", err)
+	}
+
+	gotEntropy, _, err := DecipherSeed(mnemonic, nil)
+	if err != nil {
+		t.Fatalf("DecipherSeed failed: %v", err)
+	}
+	if string(gotEntropy) != string(entropy) {
+		t.Errorf("entropy mismatch: got %x, want %x", gotEntropy, entropy)
+	}
+}
+
+func TestDecipherSeedWrongPassphrase(t *testing.T) {
+	mnemonic, err := GenerateCipherSeed(testEntropy(), []byte("right"), cipherSeedBirthdayEpoch)
+	if err != nil {
+		t.Fatalf("GenerateCipherSeed failed: %v", err)
+	}
+
+	if _, _, err := DecipherSeed(mnemonic, []byte("wrong")); err == nil {
+		t.Error("expected an error when deciphering with the wrong passphrase")
+	}
+}
+
+func TestDecipherSeedRejectsMismatchedChecksum(t *testing.T) {
+	mnemonic, err := GenerateCipherSeed(testEntropy(), []byte("pw"), cipherSeedBirthdayEpoch)
+	if err != nil {
+		t.Fatalf("GenerateCipherSeed failed: %v", err)
+	}
+
+	words := strings.Fields(mnemonic)
+	corrupted := make([]string, len(words))
+	copy(corrupted, words)
+	// Flip the first word to a different wordlist entry, corrupting the
+	// checksum without necessarily producing a decryptable-but-wrong result.
+	if corrupted[0] == "abandon" {
+		corrupted[0] = "ability"
+	} else {
+		corrupted[0] = "abandon"
+	}
+
+	if _, _, err := DecipherSeed(strings.Join(corrupted, " "), []byte("pw")); err == nil {
+		t.Error("expected a checksum mismatch error for a corrupted mnemonic")
+	}
+}
+
+func TestDecipherSeedRejectsUnknownVersion(t *testing.T) {
+	mnemonic, err := GenerateCipherSeed(testEntropy(), []byte("pw"), cipherSeedBirthdayEpoch)
+	if err != nil {
+		t.Fatalf("GenerateCipherSeed failed: %v", err)
+	}
+
+	blob, err := mnemonicToCipherSeed(mnemonic)
+	if err != nil {
+		t.Fatalf("mnemonicToCipherSeed failed: %v", err)
+	}
+	blob[0] = cipherSeedVersion + 1
+
+	tampered, err := cipherSeedToMnemonic(blob)
+	if err != nil {
+		t.Fatalf("cipherSeedToMnemonic failed: %v", err)
+	}
+
+	if _, _, err := DecipherSeed(tampered, []byte("pw")); err == nil {
+		t.Error("expected an error for an unsupported cipher seed version")
+	}
+}
+
+func TestCipherSeedRoundTripLargerEntropy(t *testing.T) {
+	entropy := make([]byte, 32)
+	for i := range entropy {
+		entropy[i] = byte(i)
+	}
+	birthday := time.Date(2025, time.May, 10, 0, 0, 0, 0, time.UTC)
+
+	mnemonic, err := GenerateCipherSeed(entropy, []byte("pw"), birthday)
+	if err != nil {
+		t.Fatalf("GenerateCipherSeed failed: %v", err)
+	}
+
+	gotEntropy, gotBirthday, err := DecipherSeed(mnemonic, []byte("pw"))
+	if err != nil {
+		t.Fatalf("DecipherSeed failed: %v", err)
+	}
+	if string(gotEntropy) != string(entropy) {
+		t.Errorf("entropy mismatch: got %x, want %x", gotEntropy, entropy)
+	}
+	if !gotBirthday.Equal(birthday) {
+		t.Errorf("birthday mismatch: got %v, want %v", gotBirthday, birthday)
+	}
+}
+
+func TestGenerateCipherSeedRejectsBadEntropyLength(t *testing.T) {
+	if _, err := GenerateCipherSeed([]byte{0x01, 0x02}, []byte("pw"), cipherSeedBirthdayEpoch); err == nil {
+		t.Error("expected an error for entropy that is not a valid BIP39 entropy length")
+	}
+}
+
+func TestChangePassphrase(t *testing.T) {
+	entropy := testEntropy()
+	birthday := time.Date(2025, time.June, 15, 0, 0, 0, 0, time.UTC)
+
+	mnemonic, err := GenerateCipherSeed(entropy, []byte("old-passphrase"), birthday)
+	if err != nil {
+		t.Fatalf("GenerateCipherSeed failed: %v", err)
+	}
+
+	newMnemonic, err := ChangePassphrase(mnemonic, []byte("old-passphrase"), []byte("new-passphrase"))
+	if err != nil {
+		t.Fatalf("ChangePassphrase failed: %v", err)
+	}
+
+	if _, _, err := DecipherSeed(newMnemonic, []byte("old-passphrase")); err == nil {
+		t.Error("expected the old passphrase to no longer decrypt the re-encrypted seed")
+	}
+
+	gotEntropy, gotBirthday, err := DecipherSeed(newMnemonic, []byte("new-passphrase"))
+	if err != nil {
+		t.Fatalf("DecipherSeed with new passphrase failed: %v", err)
+	}
+	if string(gotEntropy) != string(entropy) {
+		t.Errorf("entropy mismatch after ChangePassphrase: got %x, want %x", gotEntropy, entropy)
+	}
+	if !gotBirthday.Equal(birthday) {
+		t.Errorf("birthday mismatch after ChangePassphrase: got %v, want %v", gotBirthday, birthday)
+	}
+}
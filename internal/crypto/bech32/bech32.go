@@ -0,0 +1,161 @@
+// Package bech32 implements BIP-0173 bech32 encoding and decoding, used by
+// Cosmos-family chains (and Bitcoin's bc1 SegWit addresses) to encode a
+// human-readable prefix and a checksummed payload.
+package bech32
+
+import (
+	"fmt"
+	"strings"
+)
+
+// charset is the bech32 alphabet; a character's index in it is its 5-bit
+// value.
+const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// separator joins the human-readable part to the data part.
+const separator = "1"
+
+// generator is the BCH code generator polynomial used by polymod.
+var generator = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+// polymod computes the bech32 checksum polynomial over values, per BIP-0173.
+func polymod(values []int) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (b>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+// hrpExpand spreads hrp's high bits, then a zero separator, then its low
+// bits across the checksum input, per BIP-0173.
+func hrpExpand(hrp string) []int {
+	expanded := make([]int, 0, len(hrp)*2+1)
+	for _, c := range hrp {
+		expanded = append(expanded, int(c)>>5)
+	}
+	expanded = append(expanded, 0)
+	for _, c := range hrp {
+		expanded = append(expanded, int(c)&31)
+	}
+	return expanded
+}
+
+// createChecksum computes the 6 five-bit checksum values for hrp and data.
+func createChecksum(hrp string, data []int) []int {
+	values := append(hrpExpand(hrp), data...)
+	values = append(values, 0, 0, 0, 0, 0, 0)
+	mod := polymod(values) ^ 1
+	checksum := make([]int, 6)
+	for i := 0; i < 6; i++ {
+		checksum[i] = int(mod>>uint(5*(5-i))) & 31
+	}
+	return checksum
+}
+
+// verifyChecksum reports whether data's trailing 6 values are a valid
+// bech32 checksum for hrp.
+func verifyChecksum(hrp string, data []int) bool {
+	return polymod(append(hrpExpand(hrp), data...)) == 1
+}
+
+// Encode bech32-encodes data (arbitrary-width bytes, typically a hash)
+// under hrp, converting it to 5-bit groups and appending the checksum.
+func Encode(hrp string, data []byte) (string, error) {
+	values, err := ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert data to 5-bit groups: %w", err)
+	}
+
+	combined := append(values, createChecksum(hrp, values)...)
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteString(separator)
+	for _, v := range combined {
+		sb.WriteByte(charset[v])
+	}
+	return sb.String(), nil
+}
+
+// Decode parses a bech32 string, returning its human-readable part and
+// payload (converted back to 8-bit bytes), after verifying the checksum.
+func Decode(addr string) (string, []byte, error) {
+	if strings.ToLower(addr) != addr && strings.ToUpper(addr) != addr {
+		return "", nil, fmt.Errorf("bech32 string has mixed case")
+	}
+	addr = strings.ToLower(addr)
+
+	sep := strings.LastIndex(addr, separator)
+	if sep < 1 || sep+7 > len(addr) {
+		return "", nil, fmt.Errorf("invalid bech32 string: separator %q not found in a valid position", separator)
+	}
+
+	hrp := addr[:sep]
+	dataPart := addr[sep+1:]
+
+	values := make([]int, len(dataPart))
+	for i, c := range dataPart {
+		idx := strings.IndexRune(charset, c)
+		if idx < 0 {
+			return "", nil, fmt.Errorf("invalid bech32 character %q", c)
+		}
+		values[i] = idx
+	}
+
+	if !verifyChecksum(hrp, values) {
+		return "", nil, fmt.Errorf("invalid bech32 checksum")
+	}
+
+	payload, err := ConvertBits(values[:len(values)-6], 5, 8, false)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to convert data to 8-bit bytes: %w", err)
+	}
+	return hrp, toBytes(payload), nil
+}
+
+// ConvertBits regroups a slice of fromBits-wide values into toBits-wide
+// values (e.g. 8-bit bytes to 5-bit bech32 groups and back). pad controls
+// whether a final short group is padded with zero bits (required when
+// encoding) or must already be zero (required when decoding).
+func ConvertBits[T ~byte | ~int](data []T, fromBits, toBits uint, pad bool) ([]int, error) {
+	acc, bits := 0, uint(0)
+	maxVal := (1 << toBits) - 1
+	var result []int
+
+	for _, value := range data {
+		v := int(value)
+		if v < 0 || v>>fromBits != 0 {
+			return nil, fmt.Errorf("invalid data value %d for %d-bit input", v, fromBits)
+		}
+		acc = (acc << fromBits) | v
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, (acc>>bits)&maxVal)
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			result = append(result, (acc<<(toBits-bits))&maxVal)
+		}
+	} else if bits >= fromBits || (acc<<(toBits-bits))&maxVal != 0 {
+		return nil, fmt.Errorf("invalid padding in bech32 data")
+	}
+
+	return result, nil
+}
+
+func toBytes(values []int) []byte {
+	out := make([]byte, len(values))
+	for i, v := range values {
+		out[i] = byte(v)
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+package bech32
+
+import "testing"
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	data := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13}
+
+	encoded, err := Encode("cosmos", data)
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+
+	hrp, decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if hrp != "cosmos" {
+		t.Errorf("expected hrp %q, got %q", "cosmos", hrp)
+	}
+	if len(decoded) != len(data) {
+		t.Fatalf("expected %d decoded bytes, got %d", len(data), len(decoded))
+	}
+	for i := range data {
+		if decoded[i] != data[i] {
+			t.Errorf("decoded[%d] = %x, want %x", i, decoded[i], data[i])
+		}
+	}
+}
+
+func TestDecodeKnownBIP173Vector(t *testing.T) {
+	// From the BIP-0173 test vectors.
+	hrp, data, err := Decode("A12UEL5L")
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if hrp != "a" {
+		t.Errorf("expected hrp %q, got %q", "a", hrp)
+	}
+	if len(data) != 0 {
+		t.Errorf("expected empty payload, got %d bytes", len(data))
+	}
+}
+
+func TestDecodeRejectsBadChecksum(t *testing.T) {
+	if _, _, err := Decode("a12uel5x"); err == nil {
+		t.Error("expected an error for a corrupted checksum")
+	}
+}
+
+func TestDecodeRejectsMixedCase(t *testing.T) {
+	if _, _, err := Decode("A12uel5l"); err == nil {
+		t.Error("expected an error for a mixed-case string")
+	}
+}
+
+func TestDecodeRejectsMissingSeparator(t *testing.T) {
+	if _, _, err := Decode("pzry9x0s0muk"); err == nil {
+		t.Error("expected an error for a string with no separator")
+	}
+}
@@ -54,7 +54,10 @@ func MnemonicToSeed(mnemonic, passphrase string) ([]byte, error) {
 	return seed, nil
 }
 
-// DeriveKey derives a private key from seed using BIP32 derivation path
+// DeriveKey derives a private key from seed using BIP32 derivation path.
+// The path may have any number of components - BIP44/49/84/86 all share
+// this walk, differing only in the purpose value and, at the address-
+// encoding layer, how the resulting key is turned into an address.
 func DeriveKey(seed []byte, path string) (*bip32.Key, error) {
 	derivePath, err := ParseDerivationPath(path)
 	if err != nil {
@@ -67,111 +70,105 @@ func DeriveKey(seed []byte, path string) (*bip32.Key, error) {
 		return nil, fmt.Errorf("failed to generate master key: %w", err)
 	}
 
-	// Derive child keys following the path
 	currentKey := masterKey
+	for _, component := range derivePath.Components {
+		childIndex := component.Value
+		if component.Hardened {
+			childIndex += bip32.FirstHardenedChild
+		}
 
-	// Purpose (hardened)
-	currentKey, err = currentKey.NewChildKey(derivePath.Purpose + bip32.FirstHardenedChild)
-	if err != nil {
-		return nil, fmt.Errorf("failed to derive purpose key: %w", err)
+		currentKey, err = currentKey.NewChildKey(childIndex)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive child key: %w", err)
+		}
 	}
 
-	// Coin type (hardened)
-	currentKey, err = currentKey.NewChildKey(derivePath.CoinType + bip32.FirstHardenedChild)
-	if err != nil {
-		return nil, fmt.Errorf("failed to derive coin type key: %w", err)
-	}
+	return currentKey, nil
+}
 
-	// Account (hardened)
-	currentKey, err = currentKey.NewChildKey(derivePath.Account + bip32.FirstHardenedChild)
+// DeriveMasterKey derives the root BIP32 key from seed directly, for
+// callers that need the master key itself - e.g. to compute its
+// fingerprint for a PSBT global xpub record - rather than a key derived
+// down some path.
+func DeriveMasterKey(seed []byte) (*bip32.Key, error) {
+	masterKey, err := bip32.NewMasterKey(seed)
 	if err != nil {
-		return nil, fmt.Errorf("failed to derive account key: %w", err)
+		return nil, fmt.Errorf("failed to generate master key: %w", err)
 	}
+	return masterKey, nil
+}
 
-	// Change (not hardened)
-	currentKey, err = currentKey.NewChildKey(derivePath.Change)
-	if err != nil {
-		return nil, fmt.Errorf("failed to derive change key: %w", err)
+// DeriveAccountKey derives the key at an account-level path (e.g.
+// "m/84'/0'/0'") rather than a full 5-component BIP44 path. It is used by
+// callers that need to export an account xpub instead of a single address,
+// where path length can be shorter than the usual purpose/coin/account/change/index.
+func DeriveAccountKey(seed []byte, path string) (*bip32.Key, error) {
+	if !strings.HasPrefix(path, "m/") {
+		return nil, fmt.Errorf("path must start with 'm/'")
 	}
 
-	// Index (not hardened)
-	finalKey, err := currentKey.NewChildKey(derivePath.Index)
+	masterKey, err := bip32.NewMasterKey(seed)
 	if err != nil {
-		return nil, fmt.Errorf("failed to derive index key: %w", err)
+		return nil, fmt.Errorf("failed to generate master key: %w", err)
 	}
 
-	return finalKey, nil
+	currentKey := masterKey
+	for _, component := range strings.Split(path[2:], "/") {
+		hardened := strings.HasSuffix(component, "'")
+		numStr := component
+		if hardened {
+			numStr = component[:len(component)-1]
+		}
+
+		n, err := strconv.ParseUint(numStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path component %q: %w", component, err)
+		}
+
+		index := uint32(n)
+		if hardened {
+			index += bip32.FirstHardenedChild
+		}
+
+		currentKey, err = currentKey.NewChildKey(index)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive child key: %w", err)
+		}
+	}
+
+	return currentKey, nil
 }
 
-// ParseDerivationPath parses a BIP32 derivation path string
+// ParseDerivationPath parses a BIP32 derivation path string such as
+// "m/44'/0'/0'/0/0" or "m/86'/0'/0'/0/0". Any number of components is
+// accepted, each independently hardened or not, rather than the fixed
+// 5-component purpose/coin_type/account/change/index shape: BIP49/84/86
+// paths have that same shape with a different purpose value, but other
+// callers (account-level xpub paths, hardware-wallet apps) may use a
+// different depth or hardening pattern entirely.
 func ParseDerivationPath(path string) (types.DerivationPath, error) {
 	if !strings.HasPrefix(path, "m/") {
 		return types.DerivationPath{}, fmt.Errorf("path must start with 'm/'")
 	}
 
 	parts := strings.Split(path[2:], "/")
-	if len(parts) != 5 {
-		return types.DerivationPath{}, fmt.Errorf("path must have 5 components: m/purpose'/coin_type'/account'/change/index")
-	}
+	components := make([]types.DerivationComponent, len(parts))
+	for i, part := range parts {
+		hardened := strings.HasSuffix(part, "'")
+		numStr := part
+		if hardened {
+			numStr = part[:len(part)-1]
+		}
 
-	var dp types.DerivationPath
-	var err error
-
-	// Parse purpose (should be hardened)
-	dp.Purpose, err = parsePathComponent(parts[0], true)
-	if err != nil {
-		return types.DerivationPath{}, fmt.Errorf("invalid purpose: %w", err)
-	}
-
-	// Parse coin type (should be hardened)
-	dp.CoinType, err = parsePathComponent(parts[1], true)
-	if err != nil {
-		return types.DerivationPath{}, fmt.Errorf("invalid coin type: %w", err)
-	}
+		value, err := strconv.ParseUint(numStr, 10, 32)
+		if err != nil {
+			return types.DerivationPath{}, fmt.Errorf("invalid path component %q: %w", part, err)
+		}
 
-	// Parse account (should be hardened)
-	dp.Account, err = parsePathComponent(parts[2], true)
-	if err != nil {
-		return types.DerivationPath{}, fmt.Errorf("invalid account: %w", err)
-	}
-
-	// Parse change (not hardened)
-	dp.Change, err = parsePathComponent(parts[3], false)
-	if err != nil {
-		return types.DerivationPath{}, fmt.Errorf("invalid change: %w", err)
-	}
-
-	// Parse index (not hardened)
-	dp.Index, err = parsePathComponent(parts[4], false)
-	if err != nil {
-		return types.DerivationPath{}, fmt.Errorf("invalid index: %w", err)
-	}
-
-	return dp, nil
-}
-
-// parsePathComponent parses a single component of a derivation path
-func parsePathComponent(component string, shouldBeHardened bool) (uint32, error) {
-	isHardened := strings.HasSuffix(component, "'")
-
-	if shouldBeHardened && !isHardened {
-		return 0, fmt.Errorf("component should be hardened (end with ')")
-	}
-	if !shouldBeHardened && isHardened {
-		return 0, fmt.Errorf("component should not be hardened")
-	}
-
-	numStr := component
-	if isHardened {
-		numStr = component[:len(component)-1]
-	}
-
-	val, err := strconv.ParseUint(numStr, 10, 32)
-	if err != nil {
-		return 0, fmt.Errorf("invalid number: %w", err)
+		components[i] = types.DerivationComponent{Value: uint32(value), Hardened: hardened}
 	}
 
-	return uint32(val), nil
+	return types.DerivationPath{Components: components}, nil
 }
 
 // ClearBytes securely zeros out a byte slice
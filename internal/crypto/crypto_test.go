@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -117,6 +118,10 @@ func TestMnemonicWithPassphrase(t *testing.T) {
 }
 
 func TestDerivationPathParsing(t *testing.T) {
+	comp := func(value uint32, hardened bool) types.DerivationComponent {
+		return types.DerivationComponent{Value: value, Hardened: hardened}
+	}
+
 	testCases := []struct {
 		path     string
 		expected types.DerivationPath
@@ -125,58 +130,77 @@ func TestDerivationPathParsing(t *testing.T) {
 		{
 			path: "m/44'/0'/0'/0/0",
 			expected: types.DerivationPath{
-				Purpose:  44,
-				CoinType: 0,
-				Account:  0,
-				Change:   0,
-				Index:    0,
+				Components: []types.DerivationComponent{
+					comp(44, true), comp(0, true), comp(0, true), comp(0, false), comp(0, false),
+				},
 			},
 			valid: true,
 		},
 		{
 			path: "m/84'/0'/0'/0/5",
 			expected: types.DerivationPath{
-				Purpose:  84,
-				CoinType: 0,
-				Account:  0,
-				Change:   0,
-				Index:    5,
+				Components: []types.DerivationComponent{
+					comp(84, true), comp(0, true), comp(0, true), comp(0, false), comp(5, false),
+				},
 			},
 			valid: true,
 		},
 		{
 			path: "m/44'/60'/0'/0/1",
 			expected: types.DerivationPath{
-				Purpose:  44,
-				CoinType: 60,
-				Account:  0,
-				Change:   0,
-				Index:    1,
+				Components: []types.DerivationComponent{
+					comp(44, true), comp(60, true), comp(0, true), comp(0, false), comp(1, false),
+				},
 			},
 			valid: true,
 		},
 		{
-			path:  "44'/0'/0'/0/0", // Missing m/
-			valid: false,
+			// BIP86 Taproot purpose.
+			path: "m/86'/0'/0'/0/0",
+			expected: types.DerivationPath{
+				Components: []types.DerivationComponent{
+					comp(86, true), comp(0, true), comp(0, true), comp(0, false), comp(0, false),
+				},
+			},
+			valid: true,
 		},
 		{
-			path:  "m/44/0'/0'/0/0", // Purpose not hardened
-			valid: false,
+			// BIP44 testnet coin type.
+			path: "m/44'/1'/0'/0/0",
+			expected: types.DerivationPath{
+				Components: []types.DerivationComponent{
+					comp(44, true), comp(1, true), comp(0, true), comp(0, false), comp(0, false),
+				},
+			},
+			valid: true,
 		},
 		{
-			path:  "m/44'/0/0'/0/0", // Coin type not hardened
-			valid: false,
+			// Account-level path (3 components), e.g. for an xpub export.
+			path: "m/84'/0'/0'",
+			expected: types.DerivationPath{
+				Components: []types.DerivationComponent{
+					comp(84, true), comp(0, true), comp(0, true),
+				},
+			},
+			valid: true,
 		},
 		{
-			path:  "m/44'/0'/0/0/0", // Account not hardened
-			valid: false,
+			// Nonstandard hardening pattern: accepted, since the path
+			// doesn't have to follow BIP44's specific shape.
+			path: "m/44/0'/0'/0'/0",
+			expected: types.DerivationPath{
+				Components: []types.DerivationComponent{
+					comp(44, false), comp(0, true), comp(0, true), comp(0, true), comp(0, false),
+				},
+			},
+			valid: true,
 		},
 		{
-			path:  "m/44'/0'/0'/0'/0", // Index hardened (should not be)
+			path:  "44'/0'/0'/0/0", // Missing m/
 			valid: false,
 		},
 		{
-			path:  "m/44'/0'/0'/0", // Too few components
+			path:  "m/44'/abc'/0'/0/0", // Non-numeric component
 			valid: false,
 		},
 	}
@@ -189,7 +213,7 @@ func TestDerivationPathParsing(t *testing.T) {
 				if err != nil {
 					t.Errorf("Valid path rejected: %s, error: %v", tc.path, err)
 				} else {
-					if result != tc.expected {
+					if !reflect.DeepEqual(result, tc.expected) {
 						t.Errorf("Path parsing mismatch:\nExpected: %+v\nActual:   %+v", tc.expected, result)
 					}
 
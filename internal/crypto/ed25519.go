@@ -0,0 +1,86 @@
+package crypto
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tyler-smith/go-bip32"
+)
+
+// ed25519SeedKey is the fixed HMAC key SLIP-0010 uses to derive the ed25519
+// master key from a BIP39 seed.
+const ed25519SeedKey = "ed25519 seed"
+
+// ExtendedKey is a SLIP-0010 ed25519 extended private key: the 32-byte key
+// material (used directly as an ed25519 seed) plus the chain code needed to
+// derive further children.
+type ExtendedKey struct {
+	Key       []byte
+	ChainCode []byte
+}
+
+// PrivateKey returns the ed25519 private key this extended key's Key byte
+// string seeds.
+func (k *ExtendedKey) PrivateKey() ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(k.Key)
+}
+
+// PublicKey returns the ed25519 public key this extended key's Key byte
+// string seeds.
+func (k *ExtendedKey) PublicKey() ed25519.PublicKey {
+	return k.PrivateKey().Public().(ed25519.PublicKey)
+}
+
+// DeriveKeyEd25519 derives a SLIP-0010 ed25519 extended key from seed along
+// path. Unlike secp256k1, ed25519 has no public-parent-to-public-child
+// derivation, so SLIP-0010 requires every path component to be hardened;
+// DeriveKeyEd25519 rejects a path containing a non-hardened component.
+func DeriveKeyEd25519(seed []byte, path string) (*ExtendedKey, error) {
+	if path != "m" && !strings.HasPrefix(path, "m/") {
+		return nil, fmt.Errorf("path must start with 'm/'")
+	}
+
+	mac := hmac.New(sha512.New, []byte(ed25519SeedKey))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key := &ExtendedKey{Key: sum[:32], ChainCode: sum[32:]}
+
+	if path == "m" {
+		return key, nil
+	}
+
+	for _, component := range strings.Split(strings.TrimPrefix(path, "m/"), "/") {
+		if !strings.HasSuffix(component, "'") {
+			return nil, fmt.Errorf("ed25519 derivation requires every path component to be hardened, got %q", component)
+		}
+
+		index, err := strconv.ParseUint(strings.TrimSuffix(component, "'"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path component %q: %w", component, err)
+		}
+
+		key = deriveChildEd25519(key, uint32(index)+bip32.FirstHardenedChild)
+	}
+
+	return key, nil
+}
+
+// deriveChildEd25519 computes the SLIP-0010 child key at hardenedIndex:
+// I = HMAC-SHA512(key=chainCode, data=0x00 || parentKey || ser32(hardenedIndex)).
+func deriveChildEd25519(parent *ExtendedKey, hardenedIndex uint32) *ExtendedKey {
+	mac := hmac.New(sha512.New, parent.ChainCode)
+	mac.Write([]byte{0x00})
+	mac.Write(parent.Key)
+
+	var ser32 [4]byte
+	binary.BigEndian.PutUint32(ser32[:], hardenedIndex)
+	mac.Write(ser32[:])
+
+	sum := mac.Sum(nil)
+	return &ExtendedKey{Key: sum[:32], ChainCode: sum[32:]}
+}
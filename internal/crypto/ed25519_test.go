@@ -0,0 +1,71 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestDeriveKeyEd25519IsDeterministic(t *testing.T) {
+	seed, err := MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+
+	a, err := DeriveKeyEd25519(seed, "m/44'/501'/0'/0'")
+	if err != nil {
+		t.Fatalf("DeriveKeyEd25519 returned error: %v", err)
+	}
+	b, err := DeriveKeyEd25519(seed, "m/44'/501'/0'/0'")
+	if err != nil {
+		t.Fatalf("DeriveKeyEd25519 returned error: %v", err)
+	}
+
+	if !bytes.Equal(a.Key, b.Key) || !bytes.Equal(a.ChainCode, b.ChainCode) {
+		t.Error("expected identical paths to derive identical keys")
+	}
+	if len(a.Key) != 32 {
+		t.Errorf("expected a 32-byte key, got %d bytes", len(a.Key))
+	}
+	if len(a.PublicKey()) != 32 {
+		t.Errorf("expected a 32-byte ed25519 public key, got %d bytes", len(a.PublicKey()))
+	}
+}
+
+func TestDeriveKeyEd25519DiffersByAccount(t *testing.T) {
+	seed, err := MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+
+	first, err := DeriveKeyEd25519(seed, "m/44'/501'/0'/0'")
+	if err != nil {
+		t.Fatalf("DeriveKeyEd25519 returned error: %v", err)
+	}
+	second, err := DeriveKeyEd25519(seed, "m/44'/501'/1'/0'")
+	if err != nil {
+		t.Fatalf("DeriveKeyEd25519 returned error: %v", err)
+	}
+
+	if bytes.Equal(first.Key, second.Key) {
+		t.Error("expected different accounts to derive different keys")
+	}
+}
+
+func TestDeriveKeyEd25519RejectsNonHardened(t *testing.T) {
+	seed, err := MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+
+	if _, err := DeriveKeyEd25519(seed, "m/44'/501'/0'/0"); err == nil {
+		t.Error("expected an error for a non-hardened path component")
+	}
+}
+
+func TestDeriveKeyEd25519RejectsBadPrefix(t *testing.T) {
+	if _, err := DeriveKeyEd25519([]byte("seed"), "44'/501'/0'/0'"); err == nil {
+		t.Error("expected an error for a path missing the 'm/' prefix")
+	}
+}
@@ -0,0 +1,226 @@
+package crypto
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// commonPasswords is a small built-in list of the most frequently breached
+// passwords. ScorePassphrase checks against this list before falling back to
+// pattern-based heuristics; callers with a larger corpus can extend coverage
+// via PassphrasePolicy.Dictionary.
+var commonPasswords = []string{
+	"password", "123456", "12345678", "qwerty", "letmein", "admin",
+	"welcome", "monkey", "dragon", "football", "iloveyou", "trustno1",
+	"abc123", "111111", "123123", "password1",
+}
+
+// leetSubstitutions maps common l33t-speak substitutions back to the letter
+// they stand in for, so "p4ssw0rd" is recognized as "password".
+var leetSubstitutions = map[rune]rune{
+	'0': 'o', '1': 'i', '3': 'e', '4': 'a', '5': 's', '7': 't', '@': 'a', '$': 's',
+}
+
+// PassphrasePolicy gates passphrase acceptance in MnemonicToSeedWithPolicy.
+// MinScore is compared against ScorePassphrase's 0-4 result, MinLength sets
+// an absolute floor regardless of score, and Dictionary supplies additional
+// known-weak words (e.g. the wallet owner's name) to reject beyond the
+// built-in common-password list.
+type PassphrasePolicy struct {
+	MinScore   int
+	MinLength  int
+	Dictionary []string
+}
+
+// normalizeForMatching lowercases pw and reverses common l33t substitutions,
+// so dictionary and sequence checks see the underlying word.
+func normalizeForMatching(pw string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(pw) {
+		if replacement, ok := leetSubstitutions[r]; ok {
+			sb.WriteRune(replacement)
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// hasSequence reports whether s contains a run of length >= 4 of either
+// consecutive ascending/descending letters or digits (e.g. "abcd", "4321").
+func hasSequence(s string) bool {
+	const runLength = 4
+	run := 1
+	for i := 1; i < len(s); i++ {
+		if s[i] == s[i-1]+1 || s[i] == s[i-1]-1 {
+			run++
+			if run >= runLength {
+				return true
+			}
+		} else {
+			run = 1
+		}
+	}
+	return false
+}
+
+// hasRepeat reports whether s contains the same character repeated 3 or more
+// times in a row (e.g. "aaa").
+func hasRepeat(s string) bool {
+	const runLength = 3
+	run := 1
+	for i := 1; i < len(s); i++ {
+		if s[i] == s[i-1] {
+			run++
+			if run >= runLength {
+				return true
+			}
+		} else {
+			run = 1
+		}
+	}
+	return false
+}
+
+// charsetSize estimates the size of the character set pw draws from, for a
+// brute-force guess-count fallback when no weaker pattern is found.
+func charsetSize(pw string) int {
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+	for _, r := range pw {
+		switch {
+		case r >= 'a' && r <= 'z':
+			hasLower = true
+		case r >= 'A' && r <= 'Z':
+			hasUpper = true
+		case r >= '0' && r <= '9':
+			hasDigit = true
+		default:
+			hasSymbol = true
+		}
+	}
+
+	size := 0
+	if hasLower {
+		size += 26
+	}
+	if hasUpper {
+		size += 26
+	}
+	if hasDigit {
+		size += 10
+	}
+	if hasSymbol {
+		size += 33
+	}
+	if size == 0 {
+		size = 1
+	}
+	return size
+}
+
+// guessesPerSecondOffline is the assumed attack rate for the crack-time
+// estimate: a fast offline attack against an unsalted or weakly-hashed
+// target, per the attack model zxcvbn itself defaults to.
+const guessesPerSecondOffline = 1e10
+
+// ScorePassphrase estimates the strength of pw on zxcvbn's familiar 0-4
+// scale (0 = too guessable, 4 = very unguessable), returning an estimated
+// crack time in seconds under an offline attack and human-readable feedback
+// explaining the weakest pattern found. It checks, in order: membership in
+// the built-in common-password list (as typed, and after reversing l33t
+// substitutions), repeated characters, sequential runs, and a minimum
+// length of 8, before falling back to a brute-force estimate over the
+// passphrase's apparent character set. Caller-supplied dictionary words are
+// not checked here; see PassphrasePolicy.Dictionary. The first matching weak pattern wins, since it dominates an
+// attacker's actual guessing strategy.
+func ScorePassphrase(pw string) (score int, crackTimeSeconds float64, feedback []string) {
+	normalized := normalizeForMatching(pw)
+	lowered := strings.ToLower(pw)
+
+	for _, common := range commonPasswords {
+		if normalized == common || lowered == common {
+			return 0, 1, []string{fmt.Sprintf("%q is one of the most commonly used passwords", pw)}
+		}
+	}
+
+	if hasRepeat(normalized) {
+		return 1, 10, []string{"repeated characters like \"aaa\" are easy to guess"}
+	}
+
+	if hasSequence(normalized) {
+		return 1, 10, []string{"sequences like \"abcd\" or \"4321\" are easy to guess"}
+	}
+
+	if len(pw) < 8 {
+		return 1, 100, []string{"passphrase is too short to resist guessing"}
+	}
+
+	guesses := math.Pow(float64(charsetSize(pw)), float64(len(pw)))
+	crackTimeSeconds = guesses / guessesPerSecondOffline
+
+	switch {
+	case crackTimeSeconds < 3600: // under an hour
+		score = 1
+		feedback = []string{"passphrase is crackable within an hour"}
+	case crackTimeSeconds < 24*3600*30: // under a month
+		score = 2
+		feedback = []string{"add more words or characters to resist sustained guessing"}
+	case crackTimeSeconds < 24*3600*30*12*10: // under a decade
+		score = 3
+	default:
+		score = 4
+	}
+
+	return score, crackTimeSeconds, feedback
+}
+
+// checkPassphrasePolicy rejects passphrase if it fails policy. An empty
+// passphrase always passes, since BIP39 treats it as "no passphrase" rather
+// than a user secret to score.
+func checkPassphrasePolicy(passphrase string, policy PassphrasePolicy) error {
+	if passphrase == "" {
+		return nil
+	}
+
+	if policy.MinLength > 0 && len(passphrase) < policy.MinLength {
+		return fmt.Errorf("passphrase must be at least %d characters", policy.MinLength)
+	}
+
+	normalized := normalizeForMatching(passphrase)
+	for _, word := range policy.Dictionary {
+		if normalized == strings.ToLower(word) {
+			return fmt.Errorf("passphrase matches a disallowed dictionary word")
+		}
+	}
+
+	score, _, feedback := ScorePassphrase(passphrase)
+	if score < policy.MinScore {
+		return fmt.Errorf("passphrase too weak (score %d, need %d): %s", score, policy.MinScore, strings.Join(feedback, "; "))
+	}
+
+	return nil
+}
+
+// MnemonicToSeedWithPolicy is MnemonicToSeed with an additional passphrase
+// strength gate: it rejects a non-empty passphrase that fails policy before
+// ever deriving a seed from it.
+func MnemonicToSeedWithPolicy(mnemonic, passphrase string, policy PassphrasePolicy) ([]byte, error) {
+	if err := checkPassphrasePolicy(passphrase, policy); err != nil {
+		return nil, err
+	}
+
+	return MnemonicToSeed(mnemonic, passphrase)
+}
+
+// GenerateMnemonicWithPassphrase is GenerateMnemonic followed immediately by
+// a passphrase strength check against policy, so wallet creation can refuse
+// a weak passphrase before the mnemonic is ever shown to the user. An empty
+// passphrase is always accepted; see MnemonicToSeedWithPolicy.
+func GenerateMnemonicWithPassphrase(entropyBits int, passphrase string, policy PassphrasePolicy) (string, error) {
+	if err := checkPassphrasePolicy(passphrase, policy); err != nil {
+		return "", err
+	}
+
+	return GenerateMnemonic(entropyBits)
+}
@@ -0,0 +1,105 @@
+package crypto
+
+import "testing"
+
+func TestScorePassphraseWeakInputs(t *testing.T) {
+	testCases := []struct {
+		passphrase string
+		maxScore   int
+	}{
+		{"password", 0},
+		{"12345678", 1},
+		{"p4ssw0rd", 0},
+		{"aaaaaaaa", 1},
+		{"abcdefgh", 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.passphrase, func(t *testing.T) {
+			score, crackTimeSeconds, feedback := ScorePassphrase(tc.passphrase)
+			if score > tc.maxScore {
+				t.Errorf("expected score <= %d for %q, got %d", tc.maxScore, tc.passphrase, score)
+			}
+			if crackTimeSeconds <= 0 {
+				t.Errorf("expected a positive crack time estimate, got %f", crackTimeSeconds)
+			}
+			if len(feedback) == 0 {
+				t.Errorf("expected feedback explaining the weakness of %q", tc.passphrase)
+			}
+		})
+	}
+}
+
+func TestScorePassphraseStrongInput(t *testing.T) {
+	score, crackTimeSeconds, _ := ScorePassphrase("correct horse battery staple")
+	if score < 3 {
+		t.Errorf("expected a high score for a long multi-word passphrase, got %d", score)
+	}
+	if crackTimeSeconds < 24*3600*30 {
+		t.Errorf("expected a crack time estimate of at least a month, got %f seconds", crackTimeSeconds)
+	}
+}
+
+func TestMnemonicToSeedWithPolicyRejectsWeakPassphrase(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	policy := PassphrasePolicy{MinScore: 3, MinLength: 8}
+
+	if _, err := MnemonicToSeedWithPolicy(mnemonic, "password", policy); err == nil {
+		t.Error("expected a weak passphrase to be rejected")
+	}
+}
+
+func TestMnemonicToSeedWithPolicyAcceptsStrongPassphrase(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	policy := PassphrasePolicy{MinScore: 3, MinLength: 8}
+
+	seed, err := MnemonicToSeedWithPolicy(mnemonic, "correct horse battery staple", policy)
+	if err != nil {
+		t.Fatalf("expected a strong passphrase to be accepted, got error: %v", err)
+	}
+	defer SecureZeroMemory(seed)
+
+	if len(seed) != 64 {
+		t.Errorf("expected a 64-byte seed, got %d", len(seed))
+	}
+}
+
+func TestMnemonicToSeedWithPolicyAllowsEmptyPassphrase(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	policy := PassphrasePolicy{MinScore: 4, MinLength: 20}
+
+	seed, err := MnemonicToSeedWithPolicy(mnemonic, "", policy)
+	if err != nil {
+		t.Fatalf("expected an empty passphrase to bypass policy, got error: %v", err)
+	}
+	defer SecureZeroMemory(seed)
+}
+
+func TestMnemonicToSeedWithPolicyRejectsDictionaryWord(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	policy := PassphrasePolicy{MinScore: 0, Dictionary: []string{"satoshi"}}
+
+	if _, err := MnemonicToSeedWithPolicy(mnemonic, "satoshi", policy); err == nil {
+		t.Error("expected a dictionary word to be rejected regardless of score")
+	}
+}
+
+func TestGenerateMnemonicWithPassphraseRejectsWeakPassphrase(t *testing.T) {
+	policy := PassphrasePolicy{MinScore: 3, MinLength: 8}
+
+	if _, err := GenerateMnemonicWithPassphrase(128, "12345678", policy); err == nil {
+		t.Error("expected a weak passphrase to be rejected before generating a mnemonic")
+	}
+}
+
+func TestGenerateMnemonicWithPassphraseAcceptsNoPassphrase(t *testing.T) {
+	policy := PassphrasePolicy{MinScore: 4}
+
+	mnemonic, err := GenerateMnemonicWithPassphrase(128, "", policy)
+	if err != nil {
+		t.Fatalf("expected generation without a passphrase to succeed, got error: %v", err)
+	}
+	if mnemonic == "" {
+		t.Error("expected a non-empty mnemonic")
+	}
+}
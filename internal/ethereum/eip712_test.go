@@ -0,0 +1,186 @@
+package ethereum
+
+import (
+	"math/big"
+	"testing"
+
+	"anvil/internal/crypto"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// mailTypedData builds the canonical "Mail" example from the EIP-712
+// specification itself.
+func mailTypedData() *TypedData {
+	return &TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Person": {
+				{Name: "name", Type: "string"},
+				{Name: "wallet", Type: "address"},
+			},
+			"Mail": {
+				{Name: "from", Type: "Person"},
+				{Name: "to", Type: "Person"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Ether Mail",
+			Version:           "1",
+			ChainId:           (*math.HexOrDecimal256)(big.NewInt(1)),
+			VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: apitypes.TypedDataMessage{
+			"from": map[string]interface{}{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+			"to": map[string]interface{}{
+				"name":   "Bob",
+				"wallet": "0xbBbBBBBBbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+			},
+			"contents": "Hello, Bob!",
+		},
+	}
+}
+
+func TestEIP712MailExampleHash(t *testing.T) {
+	hash, _, err := apitypes.TypedDataAndHash(*mailTypedData())
+	if err != nil {
+		t.Fatalf("TypedDataAndHash failed: %v", err)
+	}
+
+	// The canonical signing hash for the EIP-712 spec's Mail example.
+	want := "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"
+	if got := hexString(hash); got != want {
+		t.Errorf("signing hash = %s, want %s", got, want)
+	}
+}
+
+func TestEIP712SignMailExampleRoundTrips(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	eth := NewEthereum()
+	path := "m/44'/60'/0'/0/0"
+	account, err := eth.DeriveAccount(seed, path)
+	if err != nil {
+		t.Fatalf("DeriveAccount failed: %v", err)
+	}
+
+	typedData := mailTypedData()
+	sig, err := eth.EIP712Sign(seed, path, typedData)
+	if err != nil {
+		t.Fatalf("EIP712Sign failed: %v", err)
+	}
+	if len(sig) != 65 {
+		t.Fatalf("expected 65-byte signature, got %d bytes", len(sig))
+	}
+	if sig[64] != 27 && sig[64] != 28 {
+		t.Errorf("expected v in {27, 28}, got %d", sig[64])
+	}
+
+	recovered, err := RecoverEIP712Address(typedData, sig)
+	if err != nil {
+		t.Fatalf("RecoverEIP712Address failed: %v", err)
+	}
+	if recovered != account.Address {
+		t.Errorf("recovered address %s, want %s", recovered, account.Address)
+	}
+}
+
+func TestEIP712NestedStructAndArray(t *testing.T) {
+	// Extends the Mail example with a "cc" array of Person, to exercise
+	// encodeData's array handling (keccak256 of concatenated element
+	// hashes) alongside the existing nested Person structs.
+	typedData := &TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Person": {
+				{Name: "name", Type: "string"},
+				{Name: "wallet", Type: "address"},
+			},
+			"Mail": {
+				{Name: "from", Type: "Person"},
+				{Name: "to", Type: "Person"},
+				{Name: "cc", Type: "Person[]"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Ether Mail",
+			Version:           "1",
+			ChainId:           (*math.HexOrDecimal256)(big.NewInt(1)),
+			VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: apitypes.TypedDataMessage{
+			"from": map[string]interface{}{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+			"to": map[string]interface{}{
+				"name":   "Bob",
+				"wallet": "0xbBbBBBBBbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+			},
+			"cc": []interface{}{
+				map[string]interface{}{
+					"name":   "Alice",
+					"wallet": "0xD1a2BBB2f8E7B9d3d5b2c4c3aE1F2cB6d8a5b4C3",
+				},
+			},
+			"contents": "Hello, Bob! (and Alice)",
+		},
+	}
+
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	eth := NewEthereum()
+	path := "m/44'/60'/0'/0/0"
+	account, err := eth.DeriveAccount(seed, path)
+	if err != nil {
+		t.Fatalf("DeriveAccount failed: %v", err)
+	}
+
+	sig, err := eth.EIP712Sign(seed, path, typedData)
+	if err != nil {
+		t.Fatalf("EIP712Sign with a nested array field failed: %v", err)
+	}
+
+	recovered, err := RecoverEIP712Address(typedData, sig)
+	if err != nil {
+		t.Fatalf("RecoverEIP712Address failed: %v", err)
+	}
+	if recovered != account.Address {
+		t.Errorf("recovered address %s, want %s", recovered, account.Address)
+	}
+}
+
+func hexString(b []byte) string {
+	const hexDigits = "0123456789abcdef"
+	out := make([]byte, len(b)*2)
+	for i, c := range b {
+		out[i*2] = hexDigits[c>>4]
+		out[i*2+1] = hexDigits[c&0x0f]
+	}
+	return string(out)
+}
@@ -1,43 +1,117 @@
 package ethereum
 
 import (
+	_ "embed"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"anvil/internal/crypto"
+	"anvil/pkg/coins"
 	"anvil/pkg/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 )
 
-// EthereumCoin implements the types.Coin interface for Ethereum-based coins
+// EthereumCoin implements the types.Coin interface for Ethereum-based coins.
+// It doubles as the generic "EVMCoin": every EVM-compatible chain this
+// package supports, hardcoded or loaded from evmchains.json via
+// RegisterEVMCoins, is one of these, differing only in the config NewEVMCoin
+// was built from.
 type EthereumCoin struct {
-	name     string
-	symbol   string
-	coinType uint32
-	chainID  uint64
+	name            string
+	symbol          string
+	coinType        uint32
+	chainID         uint64
+	derivationPaths []string
+	checksumMode    string
 }
 
-// NewEthereum creates a new Ethereum coin instance
-func NewEthereum() *EthereumCoin {
+// EVMChainConfig describes one EVM-compatible chain: enough metadata for
+// NewEVMCoin to build an EthereumCoin for it without a dedicated Go
+// constructor. RegisterEVMCoins loads a table of these from embedded JSON,
+// so adding a chain is a data change rather than a code change.
+type EVMChainConfig struct {
+	// Symbol is the ticker new chains register under (e.g. "MATIC"). Required.
+	Symbol string `json:"symbol"`
+	// Name is the full chain name (e.g. "Polygon").
+	Name string `json:"name"`
+	// ChainID is the EIP-155 chain ID, used for replay-protected signing and
+	// (when ChecksumMode is "eip1191") chain-aware checksums.
+	ChainID uint64 `json:"chain_id"`
+	// CoinType is the BIP44 coin type accounts on this chain derive under.
+	// Most EVM chains reuse Ethereum's 60 rather than registering their own
+	// SLIP-0044 entry, so wallets can share one seed across all of them.
+	CoinType uint32 `json:"coin_type"`
+	// DerivationPaths overrides GetStandardDerivationPaths' defaults. Empty
+	// means "derive the usual three BIP44 paths from CoinType".
+	DerivationPaths []string `json:"derivation_paths,omitempty"`
+	// ChecksumMode selects the address checksum scheme: "eip55" (default, or
+	// if empty) or "eip1191" for chains (like RSK) that salt the checksum
+	// with their chain ID. See ToChecksumAddressForChain.
+	ChecksumMode string `json:"checksum_mode,omitempty"`
+}
+
+// evmChainsJSON is the built-in EVM chain table consulted by
+// RegisterEVMCoins: every supported chain beyond Ethereum and BNB Smart
+// Chain, which keep their own NewEthereum/NewBinanceCoin constructors since
+// main.go registers those directly.
+//
+//go:embed evmchains.json
+var evmChainsJSON []byte
+
+// NewEVMCoin builds an EthereumCoin from cfg. NewEthereum and NewBinanceCoin
+// are thin wrappers around this for their two hardcoded chains; every other
+// supported EVM chain is built by RegisterEVMCoins from evmchains.json
+// instead.
+func NewEVMCoin(cfg EVMChainConfig) *EthereumCoin {
 	return &EthereumCoin{
-		name:     "Ethereum",
-		symbol:   "ETH",
-		coinType: 60, // BIP44 coin type for Ethereum
-		chainID:  1,  // Ethereum Mainnet
+		name:            cfg.Name,
+		symbol:          cfg.Symbol,
+		coinType:        cfg.CoinType,
+		chainID:         cfg.ChainID,
+		derivationPaths: cfg.DerivationPaths,
+		checksumMode:    cfg.ChecksumMode,
+	}
+}
+
+// RegisterEVMCoins parses evmchains.json and registers each chain it
+// describes in coins.DefaultRegistry, alongside whatever chains have
+// already been registered by their own constructors (ETH, BNB, ...). This
+// is how Polygon, Avalanche C-Chain, Arbitrum, Optimism, and other
+// EVM-compatible chains become available without a dedicated Go type.
+func RegisterEVMCoins() error {
+	var configs []EVMChainConfig
+	if err := json.Unmarshal(evmChainsJSON, &configs); err != nil {
+		return fmt.Errorf("failed to parse embedded EVM chain table: %w", err)
 	}
+
+	for _, cfg := range configs {
+		coins.Register(cfg.Symbol, NewEVMCoin(cfg))
+	}
+	return nil
+}
+
+// NewEthereum creates a new Ethereum coin instance
+func NewEthereum() *EthereumCoin {
+	return NewEVMCoin(EVMChainConfig{
+		Name:     "Ethereum",
+		Symbol:   "ETH",
+		CoinType: 60, // BIP44 coin type for Ethereum
+		ChainID:  1,  // Ethereum Mainnet
+	})
 }
 
 // NewBinanceCoin creates a new Binance Smart Chain coin instance
 func NewBinanceCoin() *EthereumCoin {
-	return &EthereumCoin{
-		name:     "BNB Smart Chain",
-		symbol:   "BNB",
-		coinType: 60, // Uses same coin type as Ethereum
-		chainID:  56, // BSC Mainnet
-	}
+	return NewEVMCoin(EVMChainConfig{
+		Name:     "BNB Smart Chain",
+		Symbol:   "BNB",
+		CoinType: 60, // Uses same coin type as Ethereum
+		ChainID:  56, // BSC Mainnet
+	})
 }
 
 // Name returns the full name of the cryptocurrency
@@ -75,7 +149,7 @@ func (e *EthereumCoin) DeriveAccount(seed []byte, path string) (types.Account, e
 
 	account := types.Account{
 		Path:       path,
-		PrivateKey: privateKeyBytes,
+		PrivateKey: ethcrypto.FromECDSA(privateKey),
 		PublicKey:  publicKeyBytes,
 		Address:    address,
 		Symbol:     e.symbol,
@@ -88,21 +162,58 @@ func (e *EthereumCoin) DeriveAccount(seed []byte, path string) (types.Account, e
 	return account, nil
 }
 
-// publicKeyToAddress converts an uncompressed public key to an Ethereum address
+// publicKeyToAddress converts an uncompressed public key to an address,
+// checksummed per e.checksumMode: plain EIP-55 by default, or EIP-1191
+// salted with e.chainID when checksumMode is "eip1191".
 func (e *EthereumCoin) publicKeyToAddress(publicKeyBytes []byte) string {
-	// Remove the 0x04 prefix if present (uncompressed key indicator)
+	if e.checksumMode == "eip1191" {
+		return rawAddress(publicKeyBytes, func(addr string) string {
+			return ToChecksumAddressForChain(addr, e.chainID)
+		})
+	}
+	return AddressFromPublicKey(publicKeyBytes)
+}
+
+// rawAddress derives the un-checksummed hex address from publicKeyBytes and
+// applies checksum to it. AddressFromPublicKey is the checksumMode == ""
+// (plain EIP-55) instance of this.
+func rawAddress(publicKeyBytes []byte, checksum func(string) string) string {
 	if len(publicKeyBytes) == 65 && publicKeyBytes[0] == 0x04 {
 		publicKeyBytes = publicKeyBytes[1:]
 	}
-
-	// Hash the public key with Keccak256
 	hash := ethcrypto.Keccak256Hash(publicKeyBytes)
-
-	// Take the last 20 bytes as the address
 	address := common.BytesToAddress(hash[12:])
+	return checksum(address.Hex())
+}
+
+// AddressFromPublicKey converts an uncompressed secp256k1 public key to a
+// checksummed Ethereum address. It is exported at package level so
+// hardware-wallet-derived public keys (see internal/hardware) produce the
+// same address as seed-derived accounts.
+func AddressFromPublicKey(publicKeyBytes []byte) string {
+	return rawAddress(publicKeyBytes, toChecksumAddress)
+}
+
+// SignRawTransaction signs the keccak256 hash of an already RLP-encoded,
+// unsigned transaction payload and returns a 65-byte r||s||v recoverable
+// signature. It covers the simple "sign this hash" offline flow; full
+// EIP-155/EIP-1559 transaction construction (chain ID, RLP re-assembly with
+// the signature appended) lives in BuildAndSignTx. chainParams is unused
+// here. Implements types.TransactionSigner.
+func (e *EthereumCoin) SignRawTransaction(privKey []byte, rawTx []byte, chainParams any) ([]byte, error) {
+	privateKey, err := ethcrypto.ToECDSA(privKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key: %w", err)
+	}
 
-	// Return checksummed address (EIP-55)
-	return e.toChecksumAddress(address.Hex())
+	hash := ethcrypto.Keccak256(rawTx)
+
+	signature, err := ethcrypto.Sign(hash, privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	return signature, nil
 }
 
 // ValidateAddress checks if an address is a valid Ethereum address
@@ -141,21 +252,44 @@ func (e *EthereumCoin) hasMixedCase(address string) bool {
 	return hasUpper && hasLower
 }
 
-// isValidChecksum verifies EIP-55 checksum
+// isValidChecksum verifies address against this chain's checksum scheme:
+// plain EIP-55 by default, or EIP-1191 salted with e.chainID when
+// checksumMode is "eip1191".
 func (e *EthereumCoin) isValidChecksum(address string) bool {
-	return address == e.toChecksumAddress(address)
+	if e.checksumMode == "eip1191" {
+		return address == ToChecksumAddressForChain(address, e.chainID)
+	}
+	return address == toChecksumAddress(address)
 }
 
-// GetStandardDerivationPaths returns common derivation paths for Ethereum
+// GetStandardDerivationPaths returns common derivation paths for this
+// chain: e.derivationPaths if the chain's config set one (evmchains.json),
+// or else the usual three BIP44 paths derived from its coin type.
 func (e *EthereumCoin) GetStandardDerivationPaths() []string {
-	coinType := e.coinType
+	if len(e.derivationPaths) > 0 {
+		return e.derivationPaths
+	}
+
+	base := types.Bip44{Coin: e.coinType, HardenedComponents: 3}
 	return []string{
-		fmt.Sprintf("m/44'/%d'/0'/0/0", coinType), // BIP44 standard path
-		fmt.Sprintf("m/44'/%d'/0'/0/1", coinType), // Second address
-		fmt.Sprintf("m/44'/%d'/1'/0/0", coinType), // Change addresses
+		base.ToPath(), // BIP44 standard path
+		types.Bip44Range{StartIndex: 1, Count: 1}.Expand(base)[0].ToPath(),        // Second address
+		types.Bip44{Coin: e.coinType, Account: 1, HardenedComponents: 3}.ToPath(), // Second account
 	}
 }
 
+// DeriveAccountTyped derives a new account from a structured Bip44 path,
+// rather than a free-form path string. See DeriveAccount.
+func (e *EthereumCoin) DeriveAccountTyped(seed []byte, p types.Bip44) (types.Account, error) {
+	return e.DeriveAccount(seed, p.ToPath())
+}
+
+// Curve returns the elliptic curve Ethereum-family coins derive keys on,
+// for coins.CoinDriver capability discovery.
+func (e *EthereumCoin) Curve() coins.Curve {
+	return coins.CurveSecp256k1
+}
+
 // GetCoinType returns the BIP44 coin type for this cryptocurrency
 func (e *EthereumCoin) GetCoinType() uint32 {
 	return e.coinType
@@ -167,14 +301,47 @@ func (e *EthereumCoin) GetChainID() uint64 {
 }
 
 // toChecksumAddress converts an address to EIP-55 checksum format
-func (e *EthereumCoin) toChecksumAddress(address string) string {
-	address = strings.ToLower(strings.TrimPrefix(address, "0x"))
-	hash := ethcrypto.Keccak256Hash([]byte(address))
+func toChecksumAddress(address string) string {
+	return checksumAddress(address, strings.ToLower(strings.TrimPrefix(address, "0x")))
+}
+
+// chainAwareEIP55Chains lists the chain IDs ToChecksumAddressForChain treats
+// as plain EIP-55 rather than EIP-1191 chain-salted, for backward
+// compatibility with addresses already produced by this package before
+// chain-aware checksums existed.
+var chainAwareEIP55Chains = map[uint64]bool{
+	1:  true, // Ethereum Mainnet
+	56: true, // BSC Mainnet
+}
+
+// ToChecksumAddressForChain converts an address to checksum format per
+// EIP-1191: the keccak256 input is salted with the chain ID ("<chainID
+// decimal>0x<lowercase address>" instead of EIP-55's bare lowercase
+// address), so a chain ID's wallets can't be tricked by an address that
+// only checksums correctly on a different chain - RSK's ENS integration is
+// the motivating case. chainID 1 and 56 keep plain EIP-55 output, matching
+// toChecksumAddress, so addresses this package already emits for Ethereum
+// and BSC don't change.
+func ToChecksumAddressForChain(address string, chainID uint64) string {
+	lower := strings.ToLower(strings.TrimPrefix(address, "0x"))
+	if chainAwareEIP55Chains[chainID] {
+		return checksumAddress(address, lower)
+	}
+	return checksumAddress(address, fmt.Sprintf("%d0x%s", chainID, lower))
+}
+
+// checksumAddress applies the EIP-55 case-folding rule to address's hex
+// digits, hashing hashInput (the bare lowercase address for EIP-55, or a
+// chain-ID-salted variant for EIP-1191) to decide which nibbles to
+// uppercase.
+func checksumAddress(address, hashInput string) string {
+	lower := strings.ToLower(strings.TrimPrefix(address, "0x"))
+	hash := ethcrypto.Keccak256Hash([]byte(hashInput))
 
 	result := "0x"
 	hashHex := hex.EncodeToString(hash[:])
 
-	for i, char := range address {
+	for i, char := range lower {
 		if char >= '0' && char <= '9' {
 			result += string(char)
 		} else {
@@ -189,3 +356,24 @@ func (e *EthereumCoin) toChecksumAddress(address string) string {
 
 	return result
 }
+
+// ValidateAddressForChain reports whether address is a validly formatted
+// Ethereum-style address whose checksum (if mixed-case) matches chainID's
+// EIP-1191 checksum. Unlike ValidateAddress, a mixed-case address that only
+// checksums correctly under plain EIP-55 but not under chainID's salted
+// variant is rejected - exactly the confusable-address scenario EIP-1191
+// exists to prevent.
+func ValidateAddressForChain(address string, chainID uint64) bool {
+	if !strings.HasPrefix(address, "0x") || !common.IsHexAddress(address) {
+		return false
+	}
+
+	trimmed := strings.TrimPrefix(address, "0x")
+	hasUpper := strings.ToLower(trimmed) != trimmed
+	hasLower := strings.ToUpper(trimmed) != trimmed
+	if hasUpper && hasLower {
+		return address == ToChecksumAddressForChain(address, chainID)
+	}
+
+	return true
+}
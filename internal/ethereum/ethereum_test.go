@@ -59,7 +59,7 @@ func TestEthereumAddressGeneration(t *testing.T) {
 			}
 
 			// Test EIP-55 checksum (should be properly formatted)
-			if account.Address != eth.toChecksumAddress(strings.ToLower(account.Address)) {
+			if account.Address != toChecksumAddress(strings.ToLower(account.Address)) {
 				t.Errorf("Address is not properly checksummed: %s", account.Address)
 			}
 
@@ -150,8 +150,6 @@ func TestEthereumAddressValidation(t *testing.T) {
 }
 
 func TestEIP55Checksum(t *testing.T) {
-	eth := NewEthereum()
-	
 	testCases := []struct {
 		input    string
 		expected string
@@ -167,7 +165,7 @@ func TestEIP55Checksum(t *testing.T) {
 	}
 	
 	for _, tc := range testCases {
-		result := eth.toChecksumAddress(tc.input)
+		result := toChecksumAddress(tc.input)
 		if result != tc.expected {
 			t.Errorf("Checksum mismatch:\nInput:    %s\nExpected: %s\nActual:   %s", 
 				tc.input, tc.expected, result)
@@ -235,12 +233,65 @@ func BenchmarkEthereumAddressGeneration(b *testing.B) {
 	}
 }
 
-func BenchmarkEIP55Checksum(b *testing.B) {
+func TestEIP1191ChecksumForChain(t *testing.T) {
+	// Vectors from EIP-1191 itself: an RSK address that checksums
+	// differently per chain ID, since the hash input is salted with it.
+	testCases := []struct {
+		chainID  uint64
+		expected string
+	}{
+		{30, "0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD"},
+		{31, "0x5aAeb6053F3e94c9b9A09F33669435E7EF1BEaEd"},
+	}
+
+	for _, tc := range testCases {
+		result := ToChecksumAddressForChain("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", tc.chainID)
+		if result != tc.expected {
+			t.Errorf("ToChecksumAddressForChain(chain %d) = %s, want %s", tc.chainID, result, tc.expected)
+		}
+	}
+}
+
+func TestEIP1191PreservesEIP55ForMainnetAndBSC(t *testing.T) {
+	address := "0x9858effd232b4033e47d90003d41ec34ecaeda94"
+	want := toChecksumAddress(address)
+
+	for _, chainID := range []uint64{1, 56} {
+		if got := ToChecksumAddressForChain(address, chainID); got != want {
+			t.Errorf("ToChecksumAddressForChain(chain %d) = %s, want plain EIP-55 %s", chainID, got, want)
+		}
+	}
+}
+
+func TestValidateAddressForChain(t *testing.T) {
 	eth := NewEthereum()
+
+	// Checksummed for chain 30, but not for chain 31 or plain EIP-55.
+	rskAddress := "0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD"
+
+	if !ValidateAddressForChain(rskAddress, 30) {
+		t.Errorf("expected %s to validate against chain 30", rskAddress)
+	}
+	if ValidateAddressForChain(rskAddress, 31) {
+		t.Errorf("expected %s to fail validation against chain 31", rskAddress)
+	}
+
+	// Lowercase/uppercase addresses carry no checksum to violate, so they
+	// validate under any chain ID - same as plain ValidateAddress.
+	lower := strings.ToLower(rskAddress)
+	if !ValidateAddressForChain(lower, 31) {
+		t.Errorf("expected all-lowercase %s to validate against any chain", lower)
+	}
+	if !eth.ValidateAddress(lower) {
+		t.Errorf("expected all-lowercase %s to pass plain ValidateAddress", lower)
+	}
+}
+
+func BenchmarkEIP55Checksum(b *testing.B) {
 	address := "0x9858effd232b4033e47d90003d41ec34ecaeda94"
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		eth.toChecksumAddress(address)
+		toChecksumAddress(address)
 	}
 }
@@ -0,0 +1,135 @@
+package ethereum
+
+import (
+	"encoding/json"
+	"testing"
+
+	"anvil/internal/crypto"
+	"anvil/pkg/coins"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestEmbeddedEVMChainsParse(t *testing.T) {
+	var configs []EVMChainConfig
+	if err := json.Unmarshal(evmChainsJSON, &configs); err != nil {
+		t.Fatalf("failed to parse evmchains.json: %v", err)
+	}
+
+	if len(configs) < 8 {
+		t.Fatalf("expected at least 8 EVM chains in evmchains.json, got %d", len(configs))
+	}
+
+	seen := map[string]bool{}
+	for _, cfg := range configs {
+		if cfg.Symbol == "" {
+			t.Errorf("chain config with empty Symbol: %+v", cfg)
+		}
+		if cfg.ChainID == 0 {
+			t.Errorf("chain %s has a zero chain ID", cfg.Symbol)
+		}
+		if seen[cfg.Symbol] {
+			t.Errorf("duplicate symbol %s in evmchains.json", cfg.Symbol)
+		}
+		seen[cfg.Symbol] = true
+	}
+}
+
+func TestRegisterEVMCoinsPopulatesRegistry(t *testing.T) {
+	r := coins.NewRegistry()
+	withRegistry(t, r, func() {
+		if err := RegisterEVMCoins(); err != nil {
+			t.Fatalf("RegisterEVMCoins failed: %v", err)
+		}
+
+		for _, symbol := range []string{"MATIC", "AVAX", "ARB", "OP"} {
+			driver, ok := r.Lookup(symbol)
+			if !ok {
+				t.Fatalf("expected %s to be registered", symbol)
+			}
+			if driver.Symbol() != symbol {
+				t.Errorf("driver for %s reports Symbol() = %s", symbol, driver.Symbol())
+			}
+			if driver.Curve() != coins.CurveSecp256k1 {
+				t.Errorf("%s should derive on secp256k1", symbol)
+			}
+		}
+	})
+}
+
+// withRegistry temporarily points coins.DefaultRegistry at r while fn runs,
+// restoring the original afterwards, so registration through the
+// package-level functions lands in a throwaway Registry and the test stays
+// isolated from global state any other test (or the real CLI) might also
+// touch.
+func withRegistry(t *testing.T, r *coins.Registry, fn func()) {
+	t.Helper()
+	orig := coins.DefaultRegistry
+	coins.DefaultRegistry = r
+	defer func() { coins.DefaultRegistry = orig }()
+	fn()
+}
+
+func TestNewEVMCoinDerivesAddressMatchingEthereum(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+
+	// An EVM chain sharing Ethereum's coin type and plain EIP-55 checksum
+	// mode should derive the same address as Ethereum itself for the same
+	// path - only the chain ID (used for tx signing, not derivation)
+	// differs.
+	polygon := NewEVMCoin(EVMChainConfig{Symbol: "MATIC", Name: "Polygon", ChainID: 137, CoinType: 60})
+	eth := NewEthereum()
+
+	polygonAccount, err := polygon.DeriveAccount(seed, "m/44'/60'/0'/0/0")
+	if err != nil {
+		t.Fatalf("Polygon DeriveAccount failed: %v", err)
+	}
+	ethAccount, err := eth.DeriveAccount(seed, "m/44'/60'/0'/0/0")
+	if err != nil {
+		t.Fatalf("Ethereum DeriveAccount failed: %v", err)
+	}
+
+	if polygonAccount.Address != ethAccount.Address {
+		t.Errorf("Polygon address %s should match Ethereum address %s for the same path",
+			polygonAccount.Address, ethAccount.Address)
+	}
+}
+
+func TestNewEVMCoinUsesConfiguredChecksumMode(t *testing.T) {
+	rsk := NewEVMCoin(EVMChainConfig{Symbol: "RBTC", Name: "RSK", ChainID: 30, CoinType: 137, ChecksumMode: "eip1191"})
+
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+
+	account, err := rsk.DeriveAccount(seed, "m/44'/137'/0'/0/0")
+	if err != nil {
+		t.Fatalf("DeriveAccount failed: %v", err)
+	}
+
+	want := ToChecksumAddressForChain(account.Address, 30)
+	if account.Address != want {
+		t.Errorf("RSK account address %s is not EIP-1191-checksummed for chain 30 (want %s)", account.Address, want)
+	}
+	if !rsk.ValidateAddress(account.Address) {
+		t.Errorf("RSK-derived address %s failed ValidateAddress", account.Address)
+	}
+}
+
+func TestNewEVMCoinHonorsCustomDerivationPaths(t *testing.T) {
+	custom := NewEVMCoin(EVMChainConfig{
+		Symbol:          "TEST",
+		ChainID:         9999,
+		CoinType:        60,
+		DerivationPaths: []string{"m/44'/60'/5'/0/0"},
+	})
+
+	paths := custom.GetStandardDerivationPaths()
+	if len(paths) != 1 || paths[0] != "m/44'/60'/5'/0/0" {
+		t.Errorf("GetStandardDerivationPaths() = %v, want the configured single path", paths)
+	}
+}
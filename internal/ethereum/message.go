@@ -0,0 +1,55 @@
+package ethereum
+
+import (
+	"fmt"
+	"strings"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// eip191Hash hashes message per EIP-191's "personal_sign" convention:
+// keccak256("\x19Ethereum Signed Message:\n" || len(message) || message).
+func eip191Hash(message []byte) []byte {
+	prefix := fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(message))
+	return ethcrypto.Keccak256([]byte(prefix), message)
+}
+
+// Sign signs message under the EIP-191 personal-message convention and
+// returns a 65-byte r||s||v recoverable signature. Implements types.Signer.
+func (e *EthereumCoin) Sign(privateKey, message []byte) ([]byte, error) {
+	key, err := ethcrypto.ToECDSA(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key: %w", err)
+	}
+
+	signature, err := ethcrypto.Sign(eip191Hash(message), key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message: %w", err)
+	}
+	return signature, nil
+}
+
+// Verify reports whether sig is a valid EIP-191 personal-message signature
+// of message by address. Implements types.Signer.
+func (e *EthereumCoin) Verify(address string, message, sig []byte) (bool, error) {
+	recovered, err := e.RecoverAddress(message, sig)
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(recovered, address), nil
+}
+
+// RecoverAddress recovers the signing address from an EIP-191 personal
+// message signature. Implements types.Signer.
+func (e *EthereumCoin) RecoverAddress(message, sig []byte) (string, error) {
+	if len(sig) != 65 {
+		return "", fmt.Errorf("invalid signature length: expected 65 bytes, got %d", len(sig))
+	}
+
+	publicKey, err := ethcrypto.SigToPub(eip191Hash(message), sig)
+	if err != nil {
+		return "", fmt.Errorf("failed to recover public key: %w", err)
+	}
+
+	return AddressFromPublicKey(ethcrypto.FromECDSAPub(publicKey)), nil
+}
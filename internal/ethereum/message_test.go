@@ -0,0 +1,56 @@
+package ethereum
+
+import (
+	"testing"
+
+	"anvil/internal/crypto"
+)
+
+func TestEthereumSignVerifyRecover(t *testing.T) {
+	eth := NewEthereum()
+
+	seed, err := crypto.MnemonicToSeed(
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	account, err := eth.DeriveAccount(seed, "m/44'/60'/0'/0/0")
+	if err != nil {
+		t.Fatalf("failed to derive account: %v", err)
+	}
+
+	message := []byte("hello anvil")
+	sig, err := eth.Sign(account.PrivateKey, message)
+	if err != nil {
+		t.Fatalf("Sign returned error: %v", err)
+	}
+	if len(sig) != 65 {
+		t.Fatalf("expected 65-byte signature, got %d bytes", len(sig))
+	}
+
+	recovered, err := eth.RecoverAddress(message, sig)
+	if err != nil {
+		t.Fatalf("RecoverAddress returned error: %v", err)
+	}
+	if recovered != account.Address {
+		t.Errorf("recovered address %s, want %s", recovered, account.Address)
+	}
+
+	valid, err := eth.Verify(account.Address, message, sig)
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	if !valid {
+		t.Error("expected Verify to return true for a valid signature")
+	}
+
+	valid, err = eth.Verify(account.Address, []byte("tampered message"), sig)
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	if valid {
+		t.Error("expected Verify to return false for a tampered message")
+	}
+}
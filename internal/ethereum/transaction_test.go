@@ -0,0 +1,224 @@
+package ethereum
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"anvil/internal/crypto"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func testAccount(t *testing.T, eth *EthereumCoin, path string) ([]byte, string) {
+	t.Helper()
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	account, err := eth.DeriveAccount(seed, path)
+	if err != nil {
+		t.Fatalf("DeriveAccount failed: %v", err)
+	}
+	return seed, account.Address
+}
+
+// decodeSignedTx decodes rawHex (as produced by BuildAndSignTx) and asserts
+// its signer recovers to wantFrom - the RLP/typed-transaction decoding and
+// signature-recovery logic is go-ethereum's own, so this checks
+// BuildAndSignTx's output against an independent, authoritative
+// implementation rather than a hand-maintained hex fixture.
+func decodeSignedTx(t *testing.T, rawHex string, chainID uint64, wantFrom string) *ethtypes.Transaction {
+	t.Helper()
+	raw, err := hex.DecodeString(strings.TrimPrefix(rawHex, "0x"))
+	if err != nil {
+		t.Fatalf("rawHex is not valid hex: %v", err)
+	}
+
+	decoded := new(ethtypes.Transaction)
+	if err := decoded.UnmarshalBinary(raw); err != nil {
+		t.Fatalf("failed to decode raw transaction: %v", err)
+	}
+
+	signer := ethtypes.LatestSignerForChainID(new(big.Int).SetUint64(chainID))
+	sender, err := ethtypes.Sender(signer, decoded)
+	if err != nil {
+		t.Fatalf("failed to recover sender: %v", err)
+	}
+	if got := sender.Hex(); !strings.EqualFold(got, wantFrom) {
+		t.Errorf("recovered sender %s, want %s", got, wantFrom)
+	}
+
+	return decoded
+}
+
+func TestBuildAndSignTxLegacy(t *testing.T) {
+	eth := NewEthereum()
+	seed, from := testAccount(t, eth, "m/44'/60'/0'/0/0")
+	defer crypto.SecureZeroMemory(seed)
+
+	req := TxRequest{
+		Type:     LegacyTxType,
+		ChainID:  1,
+		Nonce:    5,
+		To:       "0xbBbBBBBBbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+		Value:    big.NewInt(1_000_000_000_000_000_000),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(30_000_000_000),
+	}
+
+	rawHex, txHash, err := eth.BuildAndSignTx(seed, "m/44'/60'/0'/0/0", req)
+	if err != nil {
+		t.Fatalf("BuildAndSignTx failed: %v", err)
+	}
+	if !strings.HasPrefix(rawHex, "0x") || !strings.HasPrefix(txHash, "0x") {
+		t.Fatalf("expected 0x-prefixed rawHex/txHash, got %s / %s", rawHex, txHash)
+	}
+
+	decoded := decodeSignedTx(t, rawHex, 1, from)
+	if decoded.Type() != ethtypes.LegacyTxType {
+		t.Errorf("decoded tx type = %d, want LegacyTxType", decoded.Type())
+	}
+	if decoded.Nonce() != req.Nonce {
+		t.Errorf("nonce = %d, want %d", decoded.Nonce(), req.Nonce)
+	}
+	if decoded.Hash().Hex() != txHash {
+		t.Errorf("decoded hash %s != reported txHash %s", decoded.Hash().Hex(), txHash)
+	}
+}
+
+func TestBuildAndSignTxAccessList(t *testing.T) {
+	eth := NewEthereum()
+	seed, from := testAccount(t, eth, "m/44'/60'/0'/0/0")
+	defer crypto.SecureZeroMemory(seed)
+
+	req := TxRequest{
+		Type:     AccessListTxType,
+		ChainID:  1,
+		Nonce:    1,
+		To:       "0xbBbBBBBBbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+		GasLimit: 45000,
+		GasPrice: big.NewInt(20_000_000_000),
+		AccessList: []AccessTuple{
+			{
+				Address:     "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+				StorageKeys: []string{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+			},
+		},
+	}
+
+	rawHex, _, err := eth.BuildAndSignTx(seed, "m/44'/60'/0'/0/0", req)
+	if err != nil {
+		t.Fatalf("BuildAndSignTx failed: %v", err)
+	}
+
+	decoded := decodeSignedTx(t, rawHex, 1, from)
+	if decoded.Type() != ethtypes.AccessListTxType {
+		t.Errorf("decoded tx type = %d, want AccessListTxType", decoded.Type())
+	}
+	if len(decoded.AccessList()) != 1 {
+		t.Fatalf("expected 1 access list entry, got %d", len(decoded.AccessList()))
+	}
+}
+
+func TestBuildAndSignTxDynamicFee(t *testing.T) {
+	eth := NewEthereum()
+	seed, from := testAccount(t, eth, "m/44'/60'/0'/0/0")
+	defer crypto.SecureZeroMemory(seed)
+
+	req := TxRequest{
+		Type:                 DynamicFeeTxType,
+		ChainID:              1,
+		Nonce:                2,
+		To:                   "0xbBbBBBBBbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+		Value:                big.NewInt(42),
+		GasLimit:             21000,
+		MaxPriorityFeePerGas: big.NewInt(2_000_000_000),
+		MaxFeePerGas:         big.NewInt(50_000_000_000),
+	}
+
+	rawHex, txHash, err := eth.BuildAndSignTx(seed, "m/44'/60'/0'/0/0", req)
+	if err != nil {
+		t.Fatalf("BuildAndSignTx failed: %v", err)
+	}
+
+	decoded := decodeSignedTx(t, rawHex, 1, from)
+	if decoded.Type() != ethtypes.DynamicFeeTxType {
+		t.Errorf("decoded tx type = %d, want DynamicFeeTxType", decoded.Type())
+	}
+	if decoded.GasTipCap().Cmp(req.MaxPriorityFeePerGas) != 0 {
+		t.Errorf("GasTipCap = %s, want %s", decoded.GasTipCap(), req.MaxPriorityFeePerGas)
+	}
+	if decoded.GasFeeCap().Cmp(req.MaxFeePerGas) != 0 {
+		t.Errorf("GasFeeCap = %s, want %s", decoded.GasFeeCap(), req.MaxFeePerGas)
+	}
+	if decoded.Hash().Hex() != txHash {
+		t.Errorf("decoded hash %s != reported txHash %s", decoded.Hash().Hex(), txHash)
+	}
+}
+
+func TestBuildAndSignTxDefaultsChainIDFromCoin(t *testing.T) {
+	polygon := NewEVMCoin(EVMChainConfig{Symbol: "MATIC", ChainID: 137, CoinType: 60})
+	seed, from := testAccount(t, polygon, "m/44'/60'/0'/0/0")
+	defer crypto.SecureZeroMemory(seed)
+
+	req := TxRequest{
+		Type:     LegacyTxType,
+		Nonce:    0,
+		To:       "0xbBbBBBBBbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+		GasLimit: 21000,
+		GasPrice: big.NewInt(30_000_000_000),
+	}
+
+	rawHex, _, err := polygon.BuildAndSignTx(seed, "m/44'/60'/0'/0/0", req)
+	if err != nil {
+		t.Fatalf("BuildAndSignTx failed: %v", err)
+	}
+
+	decodeSignedTx(t, rawHex, 137, from)
+}
+
+func TestBuildAndSignTxRequiresChainID(t *testing.T) {
+	bare := NewEVMCoin(EVMChainConfig{Symbol: "TEST", CoinType: 60})
+	seed, _ := testAccount(t, bare, "m/44'/60'/0'/0/0")
+	defer crypto.SecureZeroMemory(seed)
+
+	_, _, err := bare.BuildAndSignTx(seed, "m/44'/60'/0'/0/0", TxRequest{
+		Type:     LegacyTxType,
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1),
+	})
+	if err == nil {
+		t.Fatal("expected an error when neither TxRequest.ChainID nor the coin's chain ID is set")
+	}
+}
+
+func TestERC20TransferData(t *testing.T) {
+	to := "0xbBbBBBBBbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"
+	amount := big.NewInt(1_000_000)
+
+	data, err := ERC20TransferData(to, amount)
+	if err != nil {
+		t.Fatalf("ERC20TransferData failed: %v", err)
+	}
+	if len(data) != 68 {
+		t.Fatalf("expected 68 bytes of calldata, got %d", len(data))
+	}
+
+	wantSelector := "a9059cbb"
+	if got := hex.EncodeToString(data[:4]); got != wantSelector {
+		t.Errorf("selector = %s, want %s", got, wantSelector)
+	}
+
+	gotAmount := new(big.Int).SetBytes(data[36:68])
+	if gotAmount.Cmp(amount) != 0 {
+		t.Errorf("encoded amount = %s, want %s", gotAmount, amount)
+	}
+
+	if _, err := ERC20TransferData("not-an-address", amount); err == nil {
+		t.Error("expected an error for an invalid recipient address")
+	}
+	if _, err := ERC20TransferData(to, big.NewInt(-1)); err == nil {
+		t.Error("expected an error for a negative amount")
+	}
+}
@@ -0,0 +1,78 @@
+package ethereum
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"anvil/internal/crypto"
+	"anvil/pkg/xpub"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip32"
+)
+
+// ExportAccountXPub derives the account-level extended public key for
+// accountPath (e.g. "m/44'/60'/0'") and returns it Base58Check-encoded as a
+// standard xpub. Unlike Bitcoin, which picks xpub/ypub/zpub by purpose (see
+// bitcoin.BitcoinCoin.DeriveXpub), Ethereum has no address-type variants,
+// so this always uses the plain xpub version. Loading the result into a
+// separate, offline-seed-free machine via DeriveAddressFromXPub gives that
+// machine a watch-only view of every address under accountPath.
+func (e *EthereumCoin) ExportAccountXPub(seed []byte, accountPath string) (string, error) {
+	accountKey, err := crypto.DeriveAccountKey(seed, accountPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to derive account key: %w", err)
+	}
+	neutered := accountKey.PublicKey()
+
+	fingerprint := binary.BigEndian.Uint32(neutered.FingerPrint)
+	childNumber := binary.BigEndian.Uint32(neutered.ChildNumber)
+	return xpub.Encode(xpub.VersionXpub, neutered.Depth, fingerprint, childNumber, neutered.ChainCode, neutered.Key)
+}
+
+// DeriveAddressFromXPub derives the address at the non-hardened .../change/
+// index path below an account-level xpub (as produced by
+// ExportAccountXPub), without needing the seed. Both components must be
+// non-hardened - the only thing BIP32 public-key-only derivation (CKDpub)
+// can do - which is exactly what the external (change=0) and internal
+// (change=1) address chains under a BIP44 account use.
+func DeriveAddressFromXPub(xpubStr string, change, index uint32) (string, error) {
+	if change >= bip32.FirstHardenedChild || index >= bip32.FirstHardenedChild {
+		return "", fmt.Errorf("change and index must be non-hardened (< %d)", bip32.FirstHardenedChild)
+	}
+
+	parsed, err := xpub.Parse(xpubStr)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse extended public key: %w", err)
+	}
+
+	fingerprint := make([]byte, 4)
+	binary.BigEndian.PutUint32(fingerprint, parsed.FingerPrint)
+	childNumber := make([]byte, 4)
+	binary.BigEndian.PutUint32(childNumber, parsed.ChildNumber)
+
+	accountKey := &bip32.Key{
+		Key:         append([]byte(nil), parsed.PublicKey[:]...),
+		Version:     bip32.PublicWalletVersion,
+		ChildNumber: childNumber,
+		FingerPrint: fingerprint,
+		ChainCode:   append([]byte(nil), parsed.ChainCode[:]...),
+		Depth:       parsed.Depth,
+		IsPrivate:   false,
+	}
+
+	changeKey, err := accountKey.NewChildKey(change)
+	if err != nil {
+		return "", fmt.Errorf("failed to derive change key: %w", err)
+	}
+	addressKey, err := changeKey.NewChildKey(index)
+	if err != nil {
+		return "", fmt.Errorf("failed to derive address key: %w", err)
+	}
+
+	publicKey, err := ethcrypto.DecompressPubkey(addressKey.Key)
+	if err != nil {
+		return "", fmt.Errorf("failed to decompress public key: %w", err)
+	}
+
+	return AddressFromPublicKey(ethcrypto.FromECDSAPub(publicKey)), nil
+}
@@ -0,0 +1,92 @@
+package ethereum
+
+import (
+	"strings"
+	"testing"
+
+	"anvil/internal/crypto"
+)
+
+func TestExportAccountXPubHasXPubPrefix(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	eth := NewEthereum()
+	key, err := eth.ExportAccountXPub(seed, "m/44'/60'/0'")
+	if err != nil {
+		t.Fatalf("ExportAccountXPub failed: %v", err)
+	}
+	if !strings.HasPrefix(key, "xpub") {
+		t.Errorf("ExportAccountXPub() = %s, want an xpub-prefixed key", key)
+	}
+}
+
+func TestDeriveAddressFromXPubMatchesSeedDerivedAddress(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	eth := NewEthereum()
+	xpub, err := eth.ExportAccountXPub(seed, "m/44'/60'/0'")
+	if err != nil {
+		t.Fatalf("ExportAccountXPub failed: %v", err)
+	}
+
+	testCases := []struct {
+		change, index uint32
+		path          string
+	}{
+		{0, 0, "m/44'/60'/0'/0/0"},
+		{0, 1, "m/44'/60'/0'/0/1"},
+		{1, 0, "m/44'/60'/0'/1/0"},
+	}
+
+	for _, tc := range testCases {
+		account, err := eth.DeriveAccount(seed, tc.path)
+		if err != nil {
+			t.Fatalf("DeriveAccount(%s) failed: %v", tc.path, err)
+		}
+
+		address, err := DeriveAddressFromXPub(xpub, tc.change, tc.index)
+		if err != nil {
+			t.Fatalf("DeriveAddressFromXPub(%d, %d) failed: %v", tc.change, tc.index, err)
+		}
+
+		if address != account.Address {
+			t.Errorf("DeriveAddressFromXPub(%d, %d) = %s, want %s (seed-derived %s)",
+				tc.change, tc.index, address, account.Address, tc.path)
+		}
+	}
+}
+
+func TestDeriveAddressFromXPubRejectsHardenedComponents(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	eth := NewEthereum()
+	xpub, err := eth.ExportAccountXPub(seed, "m/44'/60'/0'")
+	if err != nil {
+		t.Fatalf("ExportAccountXPub failed: %v", err)
+	}
+
+	if _, err := DeriveAddressFromXPub(xpub, 0x80000000, 0); err == nil {
+		t.Error("expected an error for a hardened change component")
+	}
+	if _, err := DeriveAddressFromXPub(xpub, 0, 0x80000000); err == nil {
+		t.Error("expected an error for a hardened index component")
+	}
+}
+
+func TestDeriveAddressFromXPubRejectsInvalidKey(t *testing.T) {
+	if _, err := DeriveAddressFromXPub("not-an-xpub", 0, 0); err == nil {
+		t.Error("expected an error for a malformed extended public key")
+	}
+}
@@ -0,0 +1,29 @@
+package hardware
+
+import (
+	"fmt"
+
+	"anvil/internal/bitcoin"
+	"anvil/internal/ethereum"
+	"anvil/internal/solana"
+	"anvil/internal/tron"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// addressFromPublicKey dispatches to the same address-encoding helpers the
+// seed-based coin packages use, so a hardware-derived public key resolves to
+// an identical address.
+func addressFromPublicKey(coin Coin, pubKey []byte) (string, error) {
+	switch coin {
+	case CoinBitcoin:
+		return bitcoin.AddressFromPublicKey(pubKey, &chaincfg.MainNetParams)
+	case CoinEthereum:
+		return ethereum.AddressFromPublicKey(pubKey), nil
+	case CoinTron:
+		return tron.AddressFromPublicKey(pubKey)
+	case CoinSolana:
+		return solana.AddressFromPublicKey(pubKey), nil
+	default:
+		return "", fmt.Errorf("hardware: unsupported coin %q", coin)
+	}
+}
@@ -0,0 +1,116 @@
+package hardware
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// APDU class/instruction bytes for the Ledger coin apps this package talks
+// to. Each coin app exposes GET_PUBLIC_KEY and SIGN under its own app-specific
+// instruction codes; the protocol framing (CLA, INS, P1, P2, Lc, data) is
+// shared across all of them.
+const (
+	claBTC = 0xE0
+	claETH = 0xE0
+	claTRX = 0xE0
+	claSOL = 0xE0
+
+	insGetPublicKeyBTC = 0x40
+	insSignBTC         = 0x44
+
+	insGetPublicKeyETH = 0x02
+	insSignETH         = 0x04
+
+	insGetPublicKeyTRX = 0x02
+	insSignTRX         = 0x04
+
+	insGetPublicKeySOL = 0x05
+	insSignSOL         = 0x06
+)
+
+// apdu builds a single-frame APDU command: CLA || INS || P1 || P2 || Lc || data.
+func apdu(cla, ins, p1, p2 byte, data []byte) []byte {
+	out := make([]byte, 5+len(data))
+	out[0] = cla
+	out[1] = ins
+	out[2] = p1
+	out[3] = p2
+	out[4] = byte(len(data))
+	copy(out[5:], data)
+	return out
+}
+
+// serializeBip32Path encodes a BIP32 path as len(N) || uint32be[N], the
+// format every Ledger app expects in the data field of GET_PUBLIC_KEY and
+// SIGN requests.
+func serializeBip32Path(path string) ([]byte, error) {
+	path = strings.TrimPrefix(path, "m/")
+	if path == "" {
+		return nil, fmt.Errorf("empty derivation path")
+	}
+
+	components := strings.Split(path, "/")
+	out := make([]byte, 1+4*len(components))
+	out[0] = byte(len(components))
+
+	for i, c := range components {
+		hardened := strings.HasSuffix(c, "'") || strings.HasSuffix(c, "h")
+		if hardened {
+			c = c[:len(c)-1]
+		}
+
+		n, err := strconv.ParseUint(c, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path component %q: %w", c, err)
+		}
+
+		index := uint32(n)
+		if hardened {
+			index |= 0x80000000
+		}
+
+		binary.BigEndian.PutUint32(out[1+4*i:], index)
+	}
+
+	return out, nil
+}
+
+// apduError wraps the two-byte status word returned after every APDU
+// exchange so callers can distinguish device rejections (e.g. user declined
+// on-screen) from transport failures.
+type apduError struct {
+	sw uint16
+}
+
+func (e *apduError) Error() string {
+	switch e.sw {
+	case 0x6985:
+		return "ledger: user rejected the operation on-device"
+	case 0x6a80, 0x6a82:
+		return "ledger: invalid derivation path or app not open"
+	case 0x6d00:
+		return "ledger: requested app not open on device"
+	default:
+		return fmt.Sprintf("ledger: device returned status word 0x%04x", e.sw)
+	}
+}
+
+// checkStatusWord splits a raw APDU response into payload and status word,
+// returning apduError when the device signaled anything other than success
+// (0x9000).
+func checkStatusWord(resp []byte) ([]byte, error) {
+	if len(resp) < 2 {
+		return nil, fmt.Errorf("ledger: short response (%d bytes)", len(resp))
+	}
+
+	sw := binary.BigEndian.Uint16(resp[len(resp)-2:])
+	payload := resp[:len(resp)-2]
+
+	if sw != 0x9000 {
+		return nil, &apduError{sw: sw}
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,108 @@
+package hardware
+
+import (
+	"bytes"
+	"testing"
+)
+
+// fakeTransport replays canned APDU responses so the Device flow can be
+// exercised without a physical Ledger attached.
+type fakeTransport struct {
+	response []byte
+	sent     []byte
+	closed   bool
+}
+
+func (f *fakeTransport) Exchange(command []byte) ([]byte, error) {
+	f.sent = command
+	return f.response, nil
+}
+
+func (f *fakeTransport) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestSerializeBip32Path(t *testing.T) {
+	encoded, err := serializeBip32Path("m/44'/60'/0'/0/0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 5 components, 1 length byte + 5*4 index bytes
+	if len(encoded) != 1+5*4 {
+		t.Fatalf("expected %d bytes, got %d", 1+5*4, len(encoded))
+	}
+	if encoded[0] != 5 {
+		t.Errorf("expected component count 5, got %d", encoded[0])
+	}
+
+	// First component (44') should be hardened: 0x8000002C
+	if !bytes.Equal(encoded[1:5], []byte{0x80, 0x00, 0x00, 0x2C}) {
+		t.Errorf("purpose not encoded as hardened 44: % x", encoded[1:5])
+	}
+
+	// Last component (address index 0) should not be hardened
+	if !bytes.Equal(encoded[17:21], []byte{0x00, 0x00, 0x00, 0x00}) {
+		t.Errorf("address index mismatch: % x", encoded[17:21])
+	}
+}
+
+func TestSerializeBip32PathRejectsGarbage(t *testing.T) {
+	if _, err := serializeBip32Path("m/not-a-number'"); err == nil {
+		t.Error("expected error for non-numeric path component")
+	}
+}
+
+func TestDeviceDeriveAddressETH(t *testing.T) {
+	// Fixture: a placeholder 65-byte uncompressed-format pubkey (0x04
+	// followed by zeros), length-prefixed, followed by a success status word.
+	pubKey := make([]byte, 65)
+	pubKey[0] = 0x04
+
+	resp := append([]byte{byte(len(pubKey))}, pubKey...)
+	resp = append(resp, 0x90, 0x00)
+
+	device := &Device{transport: &fakeTransport{response: resp}, coin: CoinEthereum}
+
+	addr, returnedKey, err := device.DeriveAddress("m/44'/60'/0'/0/0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(addr) != 42 || addr[:2] != "0x" {
+		t.Errorf("unexpected address format: %s", addr)
+	}
+	if !bytes.Equal(returnedKey, pubKey) {
+		t.Errorf("returned public key does not match fixture")
+	}
+}
+
+func TestDeviceDeriveAddressRejectedOnDevice(t *testing.T) {
+	device := &Device{transport: &fakeTransport{response: []byte{0x69, 0x85}}, coin: CoinEthereum}
+
+	if _, _, err := device.DeriveAddress("m/44'/60'/0'/0/0"); err == nil {
+		t.Error("expected error when device reports user rejection")
+	}
+}
+
+func TestDeviceSignTxSendsPathAndPayload(t *testing.T) {
+	transport := &fakeTransport{response: []byte{0x01, 0x02, 0x90, 0x00}}
+	device := &Device{transport: transport, coin: CoinSolana}
+
+	sig, err := device.SignTx("m/44'/501'/0'/0'", []byte{0xde, 0xad, 0xbe, 0xef})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(sig, []byte{0x01, 0x02}) {
+		t.Errorf("unexpected signature payload: % x", sig)
+	}
+	if !bytes.HasSuffix(transport.sent, []byte{0xde, 0xad, 0xbe, 0xef}) {
+		t.Errorf("tx payload not appended to APDU data")
+	}
+}
+
+func TestUnsupportedCoinRejected(t *testing.T) {
+	if _, _, err := getPublicKeyCommand("DOGE"); err == nil {
+		t.Error("expected error for unsupported coin")
+	}
+}
@@ -0,0 +1,154 @@
+package hardware
+
+import (
+	"fmt"
+
+	"github.com/karalabe/hid"
+)
+
+const (
+	ledgerVendorID = 0x2C97
+	hidBufferSize  = 64
+)
+
+// Transport is the minimal interface a Ledger device communication channel
+// must satisfy. It is implemented by hidTransport for real devices and by a
+// fixture-backed fake in tests so the APDU flow can be exercised without
+// hardware attached.
+type Transport interface {
+	Exchange(command []byte) ([]byte, error)
+	Close() error
+}
+
+// hidTransport sends/receives APDUs over the Ledger HID transport protocol,
+// which wraps each APDU in one or more 64-byte HID reports.
+type hidTransport struct {
+	device *hid.Device
+}
+
+// rawDeviceInfo is the subset of hid.DeviceInfo that ListDevices surfaces.
+type rawDeviceInfo struct {
+	Path         string
+	Product      string
+	SerialNumber string
+}
+
+// enumerateRaw lists every HID device matching the Ledger vendor ID.
+func enumerateRaw() ([]rawDeviceInfo, error) {
+	devices := hid.Enumerate(ledgerVendorID, 0)
+
+	infos := make([]rawDeviceInfo, len(devices))
+	for i, d := range devices {
+		infos[i] = rawDeviceInfo{Path: d.Path, Product: d.Product, SerialNumber: d.Serial}
+	}
+	return infos, nil
+}
+
+// openHIDTransport opens the first Ledger device found on the USB bus.
+func openHIDTransport() (*hidTransport, error) {
+	devices := hid.Enumerate(ledgerVendorID, 0)
+	if len(devices) == 0 {
+		return nil, fmt.Errorf("ledger: no device found (is it connected and unlocked?)")
+	}
+
+	device, err := devices[0].Open()
+	if err != nil {
+		return nil, fmt.Errorf("ledger: failed to open device: %w", err)
+	}
+
+	return &hidTransport{device: device}, nil
+}
+
+// Exchange writes a single APDU command framed per the Ledger HID protocol
+// and reads back the (possibly multi-report) response.
+func (t *hidTransport) Exchange(command []byte) ([]byte, error) {
+	if err := t.write(command); err != nil {
+		return nil, err
+	}
+	return t.read()
+}
+
+func (t *hidTransport) write(command []byte) error {
+	packets := frameHIDPackets(command)
+	for _, packet := range packets {
+		if _, err := t.device.Write(packet); err != nil {
+			return fmt.Errorf("ledger: HID write failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (t *hidTransport) read() ([]byte, error) {
+	var payload []byte
+	expected := -1
+
+	for expected < 0 || len(payload) < expected {
+		report := make([]byte, hidBufferSize)
+		n, err := t.device.Read(report)
+		if err != nil {
+			return nil, fmt.Errorf("ledger: HID read failed: %w", err)
+		}
+
+		chunk, total := parseHIDReport(report[:n], len(payload) == 0)
+		if expected < 0 {
+			expected = total
+		}
+		payload = append(payload, chunk...)
+	}
+
+	// Drop the zero padding that fills out the last 64-byte report.
+	return payload[:expected], nil
+}
+
+func (t *hidTransport) Close() error {
+	return t.device.Close()
+}
+
+// frameHIDPackets splits an APDU into 64-byte Ledger HID report packets,
+// each prefixed with a channel id, tag byte, and running sequence number.
+func frameHIDPackets(apduBytes []byte) [][]byte {
+	const channel = 0x0101
+	const tag = 0x05
+
+	var packets [][]byte
+	seq := uint16(0)
+	offset := 0
+
+	header := []byte{byte(channel >> 8), byte(channel & 0xff), tag, 0, 0, byte(len(apduBytes) >> 8), byte(len(apduBytes))}
+	for offset < len(apduBytes) || seq == 0 {
+		packet := make([]byte, hidBufferSize)
+		var n int
+		if seq == 0 {
+			n = copy(packet[len(header):], apduBytes[offset:])
+			copy(packet, header)
+			packet[3] = byte(seq >> 8)
+			packet[4] = byte(seq)
+		} else {
+			copy(packet, []byte{byte(channel >> 8), byte(channel & 0xff), tag, byte(seq >> 8), byte(seq)})
+			n = copy(packet[5:], apduBytes[offset:])
+		}
+		offset += n
+		seq++
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// parseHIDReport extracts the APDU payload chunk from a single HID report,
+// returning the chunk and (on the first report only) the total expected
+// payload length encoded in the frame header.
+func parseHIDReport(report []byte, first bool) (chunk []byte, total int) {
+	if first {
+		if len(report) < 7 {
+			return nil, 0
+		}
+		total = int(report[5])<<8 | int(report[6])
+		return report[7:], total
+	}
+
+	if len(report) < 5 {
+		return nil, 0
+	}
+	return report[5:], 0
+}
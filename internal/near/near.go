@@ -0,0 +1,100 @@
+// Package near implements the types.Coin interface for NEAR Protocol,
+// deriving ed25519 keys via SLIP-0010 (see internal/crypto.DeriveKeyEd25519)
+// since NEAR, like Solana, has no secp256k1 BIP32 derivation to reuse.
+package near
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"anvil/internal/crypto"
+	"anvil/pkg/coins"
+	"anvil/pkg/types"
+)
+
+// NearCoin implements the types.Coin interface for NEAR Protocol
+type NearCoin struct {
+	name     string
+	symbol   string
+	coinType uint32
+}
+
+// NewNear creates a new NEAR coin instance
+func NewNear() *NearCoin {
+	return &NearCoin{
+		name:     "NEAR Protocol",
+		symbol:   "NEAR",
+		coinType: 397, // BIP44 coin type for NEAR
+	}
+}
+
+// Name returns the full name of the cryptocurrency
+func (n *NearCoin) Name() string {
+	return n.name
+}
+
+// Symbol returns the symbol/ticker of the cryptocurrency
+func (n *NearCoin) Symbol() string {
+	return n.symbol
+}
+
+// DeriveAccount derives a new account for the given seed and derivation path
+func (n *NearCoin) DeriveAccount(seed []byte, path string) (types.Account, error) {
+	key, err := crypto.DeriveKeyEd25519(seed, path)
+	if err != nil {
+		return types.Account{}, fmt.Errorf("failed to derive ed25519 key: %w", err)
+	}
+
+	publicKey := key.PublicKey()
+	address := AddressFromPublicKey(publicKey)
+
+	account := types.Account{
+		Path:       path,
+		PrivateKey: key.PrivateKey(),
+		PublicKey:  publicKey,
+		Address:    address,
+		Symbol:     n.symbol,
+		CreatedAt:  time.Now(),
+	}
+
+	crypto.SecureZeroMemory(key.Key)
+
+	return account, nil
+}
+
+// AddressFromPublicKey derives a NEAR implicit account ID from an ed25519
+// public key: the lowercase hex encoding of the raw public key bytes.
+func AddressFromPublicKey(publicKey ed25519.PublicKey) string {
+	return hex.EncodeToString(publicKey)
+}
+
+// ValidateAddress checks if an address is a valid NEAR implicit account ID
+func (n *NearCoin) ValidateAddress(address string) bool {
+	if len(address) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(address)
+	return err == nil
+}
+
+// GetStandardDerivationPaths returns common derivation paths for NEAR
+func (n *NearCoin) GetStandardDerivationPaths() []string {
+	return []string{
+		fmt.Sprintf("m/44'/%d'/0'", n.coinType), // Standard NEAR path (hardened)
+		fmt.Sprintf("m/44'/%d'/1'", n.coinType), // Second account
+		fmt.Sprintf("m/44'/%d'/2'", n.coinType), // Third account
+	}
+}
+
+// Curve returns the elliptic curve NEAR derives keys on, for
+// coins.CoinDriver capability discovery.
+func (n *NearCoin) Curve() coins.Curve {
+	return coins.CurveEd25519
+}
+
+// GetCoinType returns the BIP44 coin type for NEAR
+func (n *NearCoin) GetCoinType() uint32 {
+	return n.coinType
+}
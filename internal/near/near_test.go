@@ -0,0 +1,56 @@
+package near
+
+import (
+	"testing"
+
+	"anvil/internal/crypto"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestNearAddressGeneration(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	near := NewNear()
+	account, err := near.DeriveAccount(seed, "m/44'/397'/0'")
+	if err != nil {
+		t.Fatalf("DeriveAccount returned error: %v", err)
+	}
+
+	if len(account.Address) != 64 {
+		t.Errorf("expected a 64-character hex implicit account ID, got %s", account.Address)
+	}
+	if account.Symbol != "NEAR" {
+		t.Errorf("expected symbol NEAR, got %s", account.Symbol)
+	}
+	if !near.ValidateAddress(account.Address) {
+		t.Errorf("expected derived address %s to validate", account.Address)
+	}
+}
+
+func TestNearValidateAddress(t *testing.T) {
+	if NewNear().ValidateAddress("not-an-address") {
+		t.Error("expected an invalid address to fail validation")
+	}
+}
+
+func TestNearGetStandardDerivationPaths(t *testing.T) {
+	paths := NewNear().GetStandardDerivationPaths()
+	expected := []string{
+		"m/44'/397'/0'",
+		"m/44'/397'/1'",
+		"m/44'/397'/2'",
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected %d paths, got %d", len(expected), len(paths))
+	}
+	for i, p := range paths {
+		if p != expected[i] {
+			t.Errorf("paths[%d] = %s, want %s", i, p, expected[i])
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package output
+
+// descriptorChecksum implements the BIP-380 output descriptor checksum
+// (the same algorithm Bitcoin Core uses for `getdescriptorinfo`/
+// `importdescriptors`), so generated descriptors carry a checksum watch-only
+// wallets will actually accept.
+
+const descsumCharset = "0123456789()[],'/*abcdefgh@:$%{}IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+
+// descsumChecksumCharset is the bech32 alphabet the 8 checksum characters
+// themselves are written in.
+const descsumChecksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+var descsumGenerator = [5]uint64{
+	0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a, 0x644d626ffd,
+}
+
+func descsumPolymod(c uint64, val uint64) uint64 {
+	c0 := c >> 35
+	c = ((c & 0x7ffffffff) << 5) ^ val
+	for i := 0; i < 5; i++ {
+		if (c0>>uint(i))&1 != 0 {
+			c ^= descsumGenerator[i]
+		}
+	}
+	return c
+}
+
+// descriptorChecksum computes the 8-character BIP-380 checksum for a
+// descriptor string (without the trailing "#checksum").
+func descriptorChecksum(descriptor string) string {
+	c := uint64(1)
+	cls := 0
+	clscount := 0
+
+	for _, ch := range descriptor {
+		pos := indexByte(descsumCharset, byte(ch))
+		if pos == -1 {
+			return ""
+		}
+		// Emit a symbol for the position inside the group.
+		c = descsumPolymod(c, uint64(pos&31))
+		// Accumulate the group number for this character.
+		cls = cls*3 + pos>>5
+		clscount++
+		if clscount == 3 {
+			c = descsumPolymod(c, uint64(cls))
+			cls = 0
+			clscount = 0
+		}
+	}
+	if clscount > 0 {
+		c = descsumPolymod(c, uint64(cls))
+	}
+	for j := 0; j < 8; j++ {
+		c = descsumPolymod(c, 0)
+	}
+	c ^= 1
+
+	checksum := make([]byte, 8)
+	for j := 0; j < 8; j++ {
+		checksum[j] = descsumChecksumCharset[(c>>uint(5*(7-j)))&31]
+	}
+	return string(checksum)
+}
+
+// withChecksum appends "#<checksum>" to a descriptor, matching the format
+// Bitcoin Core's importdescriptors expects.
+func withChecksum(descriptor string) string {
+	return descriptor + "#" + descriptorChecksum(descriptor)
+}
+
+func indexByte(s string, b byte) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] == b {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,102 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"anvil/pkg/keystore"
+	"anvil/pkg/types"
+)
+
+// generateKeystore writes one Web3 Secret Storage V3 file per secp256k1
+// account in the wallet (Ethereum, BNB, TRON) to options.KeystoreDir. ed25519
+// accounts (e.g. Solana) are skipped with a warning unless
+// KeystoreAllowEd25519 is set, since V3 has no standard representation for
+// them.
+func (g *Generator) generateKeystore(wallet *types.Wallet) error {
+	if g.options.KeystoreDir == "" {
+		return fmt.Errorf("keystore output requires a --keystore-dir")
+	}
+	if g.options.KeystorePassphrase == "" {
+		return fmt.Errorf("keystore output requires a --keystore-passphrase-file")
+	}
+
+	if err := os.MkdirAll(g.options.KeystoreDir, 0700); err != nil {
+		return fmt.Errorf("failed to create keystore directory: %w", err)
+	}
+
+	for _, account := range wallet.Accounts {
+		if len(account.PrivateKey) == 0 {
+			continue
+		}
+
+		var (
+			ks  keystore.V3
+			err error
+		)
+
+		switch {
+		case isSecp256k1Symbol(account.Symbol):
+			ks, err = newKeystoreV3(account.PrivateKey, account.Address, g.options.KeystorePassphrase)
+		case g.options.KeystoreAllowEd25519:
+			ks, err = newKeystoreEd25519(account.PrivateKey, account.Address, g.options.KeystorePassphrase)
+		default:
+			fmt.Fprintf(os.Stderr, "⚠️  skipping %s account %s: keystore V3 only supports secp256k1 keys (pass --keystore-allow-ed25519 to emit an Anvil-specific variant)\n", account.Symbol, account.Address)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to encrypt %s keystore: %w", account.Symbol, err)
+		}
+
+		file := filepath.Join(g.options.KeystoreDir, keystoreFileName(account.Address))
+		data, err := json.MarshalIndent(ks, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal keystore: %w", err)
+		}
+		if err := os.WriteFile(file, data, 0600); err != nil {
+			return fmt.Errorf("failed to write keystore file: %w", err)
+		}
+		fmt.Printf("Wrote keystore for %s %s: %s\n", account.Symbol, account.Address, file)
+	}
+
+	return nil
+}
+
+// isSecp256k1Symbol reports whether the given coin symbol uses ECDSA/secp256k1
+// keys and can therefore be represented as a standard V3 keystore.
+func isSecp256k1Symbol(symbol string) bool {
+	switch symbol {
+	case "ETH", "BNB", "TRX":
+		return true
+	default:
+		return false
+	}
+}
+
+// newKeystoreV3 encrypts privateKey into the standard go-ethereum-compatible
+// V3 structure.
+func newKeystoreV3(privateKey []byte, address, passphrase string) (keystore.V3, error) {
+	return keystore.EncryptV3(privateKey, address, passphrase)
+}
+
+// newKeystoreEd25519 encrypts an ed25519 private key using the same
+// scrypt+AES-CTR envelope as newKeystoreV3, documented as an Anvil-specific
+// (non-standard) extension since V3 itself has no ed25519 curve field.
+func newKeystoreEd25519(privateKey []byte, address, passphrase string) (keystore.V3, error) {
+	ks, err := keystore.EncryptV3(privateKey, address, passphrase)
+	if err != nil {
+		return keystore.V3{}, err
+	}
+	ks.Version = -1 // Anvil-specific: not a standard V3 curve, don't claim otherwise.
+	return ks, nil
+}
+
+// keystoreFileName matches go-ethereum's "UTC--<iso8601>--<address>" convention.
+func keystoreFileName(address string) string {
+	ts := time.Now().UTC().Format("2006-01-02T15-04-05.000000000Z")
+	return fmt.Sprintf("UTC--%s--%s", ts, strings.ToLower(strings.TrimPrefix(address, "0x")))
+}
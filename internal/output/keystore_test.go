@@ -0,0 +1,81 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"anvil/internal/crypto"
+	"anvil/internal/ethereum"
+	"anvil/pkg/keystore"
+	"anvil/pkg/types"
+)
+
+// TestGenerateKeystoreRoundTripsDerivedPrivateKey builds its account via
+// ethereum.EthereumCoin.DeriveAccount, the way generate/recover do, rather
+// than hand-setting Account.PrivateKey like createTestWallet does - so it
+// would have caught DeriveAccount handing back a zeroed key (see
+// chunk1-2) before it reached a keystore file.
+func TestGenerateKeystoreRoundTripsDerivedPrivateKey(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	eth := ethereum.NewEthereum()
+	path := "m/44'/60'/0'/0/0"
+	account, err := eth.DeriveAccount(seed, path)
+	if err != nil {
+		t.Fatalf("DeriveAccount failed: %v", err)
+	}
+	if bytes.Equal(account.PrivateKey, make([]byte, len(account.PrivateKey))) {
+		t.Fatal("DeriveAccount returned an all-zero private key")
+	}
+
+	dir := t.TempDir()
+	generator := &Generator{
+		options: types.OutputOptions{
+			KeystoreDir:        dir,
+			KeystorePassphrase: "correct horse battery staple",
+		},
+	}
+	wallet := &types.Wallet{
+		Version:   "test",
+		CreatedAt: time.Now(),
+		Accounts:  []types.Account{account},
+		CoinTypes: map[string][]uint32{"ETH": {60}},
+	}
+
+	if err := generator.generateKeystore(wallet); err != nil {
+		t.Fatalf("generateKeystore failed: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read keystore dir: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 keystore file, got %d", len(entries))
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	if err != nil {
+		t.Fatalf("failed to read keystore file: %v", err)
+	}
+	var ks keystore.V3
+	if err := json.Unmarshal(data, &ks); err != nil {
+		t.Fatalf("failed to parse keystore file: %v", err)
+	}
+
+	decrypted, err := keystore.DecryptV3(ks, "correct horse battery staple")
+	if err != nil {
+		t.Fatalf("failed to decrypt keystore: %v", err)
+	}
+	if !bytes.Equal(decrypted, account.PrivateKey) {
+		t.Errorf("decrypted keystore private key does not match the derived account's")
+	}
+}
@@ -9,10 +9,26 @@ import (
 	"text/template"
 	"time"
 
+	"anvil/internal/bitcoin"
+	"anvil/internal/crypto"
 	"anvil/pkg/types"
 	"github.com/skip2/go-qrcode"
+	"github.com/tyler-smith/go-bip39"
 )
 
+// purposeLabel returns a short label for the purpose a derivation path was
+// derived under (e.g. "Native SegWit (BIP84)"), so text/paper output can
+// tell accounts with the same coin type and symbol apart. Paths whose
+// purpose isn't one this package recognizes (most non-Bitcoin-family
+// chains, which only ever use one address scheme) yield an empty label.
+func purposeLabel(path string) string {
+	derivePath, err := crypto.ParseDerivationPath(path)
+	if err != nil || len(derivePath.Components) == 0 {
+		return ""
+	}
+	return bitcoin.Purpose(derivePath.Components[0].Value).PurposeLabel()
+}
+
 // Generator handles different output formats for wallet data
 type Generator struct {
 	options types.OutputOptions
@@ -27,6 +43,10 @@ func NewGenerator(options types.OutputOptions) *Generator {
 
 // GenerateWallet outputs wallet data in the specified format
 func (g *Generator) GenerateWallet(wallet *types.Wallet) error {
+	if err := g.applySeedFormat(wallet); err != nil {
+		return err
+	}
+
 	switch g.options.Format {
 	case types.OutputJSON:
 		return g.generateJSON(wallet)
@@ -36,11 +56,55 @@ func (g *Generator) GenerateWallet(wallet *types.Wallet) error {
 		return g.generatePaperWallet(wallet)
 	case types.OutputQR:
 		return g.generateQRCodes(wallet)
+	case types.OutputKeystore:
+		return g.generateKeystore(wallet)
+	case types.OutputWatchOnly:
+		return g.generateWatchOnly(wallet)
+	case types.OutputShares:
+		return g.generateShares(wallet)
+	case types.OutputPSBT:
+		return g.generatePSBT(wallet)
 	default:
 		return fmt.Errorf("unsupported output format")
 	}
 }
 
+// applySeedFormat rewrites wallet.Mnemonic into its aezeed cipher-seed form
+// and records wallet.RecoveryBirthday when the caller requested
+// SeedFormat "aezeed" (see internal/crypto.GenerateCipherSeed). It is a
+// no-op for the default "plain" format, and for wallets whose mnemonic
+// won't be surfaced anyway.
+func (g *Generator) applySeedFormat(wallet *types.Wallet) error {
+	if g.options.SeedFormat == "" || g.options.SeedFormat == "plain" {
+		return nil
+	}
+	if !g.options.IncludeMnemonic || wallet.Mnemonic == "" {
+		return nil
+	}
+	if g.options.SeedFormat != "aezeed" {
+		return fmt.Errorf("unsupported --seed-format: %s", g.options.SeedFormat)
+	}
+	if g.options.SeedPassphrase == "" {
+		return fmt.Errorf("aezeed seed format requires a --seed-passphrase")
+	}
+
+	entropy, err := bip39.EntropyFromMnemonic(wallet.Mnemonic)
+	if err != nil {
+		return fmt.Errorf("failed to recover entropy from mnemonic: %w", err)
+	}
+	defer crypto.ClearBytes(entropy)
+
+	birthday := wallet.CreatedAt
+	cipherSeed, err := crypto.GenerateCipherSeed(entropy, []byte(g.options.SeedPassphrase), birthday)
+	if err != nil {
+		return fmt.Errorf("failed to generate aezeed cipher seed: %w", err)
+	}
+
+	wallet.Mnemonic = cipherSeed
+	wallet.RecoveryBirthday = &birthday
+	return nil
+}
+
 // generateJSON outputs wallet data as JSON
 func (g *Generator) generateJSON(wallet *types.Wallet) error {
 	var data []byte
@@ -101,7 +165,7 @@ Version: {{.Version}}
 {{end}}ACCOUNTS:
 {{range .Accounts}}
 ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
-{{.Symbol}} - {{.Path}}
+{{.Symbol}} - {{.Path}}{{with purposeLabel .Path}} [{{.}}]{{end}}
 Address: {{.Address}}
 {{if $.IncludePrivate}}Private Key: {{printf "%x" .PrivateKey}}{{end}}
 Public Key:  {{printf "%x" .PublicKey}}
@@ -119,7 +183,7 @@ SUPPORTED CRYPTOCURRENCIES:
 • Verify addresses before sending funds
 `
 
-	t, err := template.New("wallet").Parse(tmpl)
+	t, err := template.New("wallet").Funcs(template.FuncMap{"purposeLabel": purposeLabel}).Parse(tmpl)
 	if err != nil {
 		return fmt.Errorf("failed to parse template: %w", err)
 	}
@@ -160,7 +224,7 @@ Generated: {{.CreatedAt.Format "January 2, 2006 at 15:04:05"}}
 
 {{range .Accounts}}
 ┌─ {{.Symbol}} WALLET ──────────────────────────────────────────────────────┐
-│ Path: {{.Path}}
+│ Path: {{.Path}}{{with purposeLabel .Path}} ({{.}}){{end}}
 │ Address: {{.Address}}
 {{if $.IncludePrivate}}│ Private: {{printf "%x" .PrivateKey}}{{end}}
 └───────────────────────────────────────────────────────────────────────┘
@@ -177,7 +241,7 @@ Generated: {{.CreatedAt.Format "January 2, 2006 at 15:04:05"}}
 ⚠️  This paper contains sensitive cryptographic keys. Treat it like cash! ⚠️
 `
 
-	t, err := template.New("paper").Parse(tmpl)
+	t, err := template.New("paper").Funcs(template.FuncMap{"purposeLabel": purposeLabel}).Parse(tmpl)
 	if err != nil {
 		return fmt.Errorf("failed to parse template: %w", err)
 	}
@@ -328,5 +392,10 @@ func ValidateOptions(options types.OutputOptions) error {
 		}
 	}
 
+	if options.Format == types.OutputShares && options.ShareThreshold > 0 && options.ShareTotal > 0 &&
+		options.ShareThreshold > options.ShareTotal {
+		return fmt.Errorf("--shares-threshold (%d) cannot exceed --shares-total (%d)", options.ShareThreshold, options.ShareTotal)
+	}
+
 	return nil
 }
@@ -154,6 +154,31 @@ func TestGenerateText(t *testing.T) {
 	if !strings.Contains(output, "BTC") {
 		t.Error("Text output should contain BTC")
 	}
+	if !strings.Contains(output, "Legacy (BIP44)") {
+		t.Error("Text output should label the BIP44 account's purpose")
+	}
+}
+
+func TestPurposeLabel(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected string
+	}{
+		{"m/44'/0'/0'/0/0", "Legacy (BIP44)"},
+		{"m/49'/0'/0'/0/0", "SegWit (BIP49)"},
+		{"m/84'/0'/0'/0/0", "Native SegWit (BIP84)"},
+		{"m/86'/0'/0'/0/0", "Taproot (BIP86)"},
+		{"m/44'/60'/0'/0/0", "Legacy (BIP44)"},
+		{"not-a-path", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			if got := purposeLabel(tc.path); got != tc.expected {
+				t.Errorf("purposeLabel(%q) = %q, want %q", tc.path, got, tc.expected)
+			}
+		})
+	}
 }
 
 func TestWriteOutput(t *testing.T) {
@@ -0,0 +1,152 @@
+package output
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"anvil/internal/bitcoin"
+	"anvil/internal/crypto"
+	"anvil/pkg/types"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	bip32 "github.com/tyler-smith/go-bip32"
+)
+
+// psbtGlobalXpubKeyType is the BIP174 PSBT_GLOBAL_XPUB key type: a global
+// key-value pair whose key is 0x01 followed by the 78-byte serialized
+// extended public key, and whose value is the master key fingerprint
+// followed by the derivation path from master to that xpub.
+const psbtGlobalXpubKeyType = 0x01
+
+// generatePSBT emits, for every BTC/DOGE account in the wallet, an
+// unsigned BIP174 PSBT skeleton (no inputs or outputs of its own) whose
+// global map carries that account's xpub, master key fingerprint, and
+// derivation path - enough for a watch-only wallet (Sparrow, Electrum,
+// Bitcoin Core) to import the account as a signer without ever seeing a
+// private key.
+func (g *Generator) generatePSBT(wallet *types.Wallet) error {
+	if len(wallet.Seed) == 0 {
+		return fmt.Errorf("PSBT export requires the wallet seed; re-run with --include-mnemonic or generate a fresh wallet")
+	}
+
+	accounts, err := btcAccountPrefixes(wallet.Accounts)
+	if err != nil {
+		return err
+	}
+	if len(accounts) == 0 {
+		return fmt.Errorf("no BTC/DOGE accounts found to export as PSBT")
+	}
+
+	fingerprint, err := bitcoin.MasterKeyFingerprint(wallet.Seed)
+	if err != nil {
+		return fmt.Errorf("failed to derive master key fingerprint: %w", err)
+	}
+
+	baseDir := g.options.FilePath
+	if baseDir == "" {
+		baseDir = "anvil-psbt"
+	}
+	if ext := filepath.Ext(baseDir); ext != "" {
+		baseDir = strings.TrimSuffix(baseDir, ext)
+	}
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		return fmt.Errorf("failed to create PSBT directory: %w", err)
+	}
+
+	for _, acct := range accounts {
+		accountKey, err := crypto.DeriveAccountKey(wallet.Seed, acct.path)
+		if err != nil {
+			return fmt.Errorf("failed to derive account key for %s: %w", acct.path, err)
+		}
+
+		packet, err := accountPSBTSkeleton(accountKey, acct.path, fingerprint)
+		if err != nil {
+			return fmt.Errorf("failed to build PSBT for %s %s: %w", acct.symbol, acct.path, err)
+		}
+
+		pathSlug := strings.NewReplacer("/", "-", "'", "h").Replace(strings.TrimPrefix(acct.path, "m/"))
+		prefix := fmt.Sprintf("%s-%s", strings.ToLower(acct.symbol), pathSlug)
+
+		var bin bytes.Buffer
+		if err := packet.Serialize(&bin); err != nil {
+			return fmt.Errorf("failed to serialize PSBT for %s: %w", acct.path, err)
+		}
+		if err := os.WriteFile(filepath.Join(baseDir, prefix+".psbt"), bin.Bytes(), 0644); err != nil {
+			return fmt.Errorf("failed to write PSBT for %s: %w", acct.path, err)
+		}
+
+		b64, err := packet.B64Encode()
+		if err != nil {
+			return fmt.Errorf("failed to base64-encode PSBT for %s: %w", acct.path, err)
+		}
+		if err := os.WriteFile(filepath.Join(baseDir, prefix+".psbt.b64"), []byte(b64+"\n"), 0644); err != nil {
+			return fmt.Errorf("failed to write base64 PSBT for %s: %w", acct.path, err)
+		}
+
+		if g.options.IncludePrivate {
+			xprv := fmt.Sprintf(
+				"SENSITIVE: %s account extended private key for %s. Never embedded in the PSBT itself - import only this account's xpub there.\n%s\n",
+				acct.symbol, acct.path, accountKey.B58Serialize())
+			if err := os.WriteFile(filepath.Join(baseDir, prefix+".xprv.txt"), []byte(xprv), 0600); err != nil {
+				return fmt.Errorf("failed to write account xprv for %s: %w", acct.path, err)
+			}
+		}
+
+		fmt.Printf("Generated PSBT skeleton for %s %s: %s\n", acct.symbol, acct.path, prefix+".psbt")
+	}
+
+	fmt.Printf("PSBTs saved to directory: %s\n", baseDir)
+	return nil
+}
+
+// accountPSBTSkeleton builds an empty (no inputs/outputs) unsigned PSBT
+// carrying a single BIP174 global xpub record for accountKey.
+func accountPSBTSkeleton(accountKey *bip32.Key, accountPath string, fingerprint uint32) (*psbt.Packet, error) {
+	packet, err := psbt.New(nil, nil, 2, 0, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PSBT skeleton: %w", err)
+	}
+
+	xpubBytes, err := accountKey.PublicKey().Serialize()
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize account xpub: %w", err)
+	}
+	key := append([]byte{psbtGlobalXpubKeyType}, xpubBytes...)
+
+	pathComponents, err := psbtDerivationPath(accountPath)
+	if err != nil {
+		return nil, err
+	}
+	value := make([]byte, 4, 4+4*len(pathComponents))
+	binary.BigEndian.PutUint32(value, fingerprint)
+	for _, c := range pathComponents {
+		b := make([]byte, 4)
+		binary.LittleEndian.PutUint32(b, c)
+		value = append(value, b...)
+	}
+
+	packet.Unknowns = append(packet.Unknowns, &psbt.Unknown{Key: key, Value: value})
+	return packet, nil
+}
+
+// psbtDerivationPath converts a derivation path string into the
+// little-endian, hardened-bit-set uint32 components BIP174's global xpub
+// record expects.
+func psbtDerivationPath(path string) ([]uint32, error) {
+	derivePath, err := crypto.ParseDerivationPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid derivation path %q: %w", path, err)
+	}
+
+	components := make([]uint32, len(derivePath.Components))
+	for i, c := range derivePath.Components {
+		components[i] = c.Value
+		if c.Hardened {
+			components[i] += bip32.FirstHardenedChild
+		}
+	}
+	return components, nil
+}
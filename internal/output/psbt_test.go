@@ -0,0 +1,132 @@
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"anvil/internal/bitcoin"
+	"anvil/internal/crypto"
+	"anvil/pkg/types"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/tyler-smith/go-bip39"
+)
+
+func createTestWalletWithSeed(t *testing.T) *types.Wallet {
+	t.Helper()
+	entropy, err := bip39.NewEntropy(128)
+	if err != nil {
+		t.Fatalf("NewEntropy failed: %v", err)
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		t.Fatalf("NewMnemonic failed: %v", err)
+	}
+
+	wallet := createTestWallet()
+	wallet.Mnemonic = mnemonic
+	wallet.Seed = bip39.NewSeed(mnemonic, "")
+	wallet.Accounts[0].Path = "m/84'/0'/0'/0/0"
+	return wallet
+}
+
+func TestGeneratePSBTRequiresSeed(t *testing.T) {
+	wallet := createTestWallet()
+	dir := t.TempDir()
+
+	generator := NewGenerator(types.OutputOptions{
+		Format:   types.OutputPSBT,
+		FilePath: filepath.Join(dir, "psbt"),
+	})
+
+	if err := generator.GenerateWallet(wallet); err == nil {
+		t.Error("expected an error when the wallet has no seed")
+	}
+}
+
+func TestGeneratePSBTWritesImportableGlobalXpub(t *testing.T) {
+	wallet := createTestWalletWithSeed(t)
+	dir := filepath.Join(t.TempDir(), "psbt")
+
+	generator := NewGenerator(types.OutputOptions{
+		Format:   types.OutputPSBT,
+		FilePath: dir,
+	})
+
+	if err := generator.GenerateWallet(wallet); err != nil {
+		t.Fatalf("GenerateWallet failed: %v", err)
+	}
+
+	b64Bytes, err := os.ReadFile(filepath.Join(dir, "btc-84h-0h-0h.psbt.b64"))
+	if err != nil {
+		t.Fatalf("failed to read base64 PSBT: %v", err)
+	}
+
+	packet, err := psbt.NewFromRawBytes(strings.NewReader(strings.TrimSpace(string(b64Bytes))), true)
+	if err != nil {
+		t.Fatalf("failed to parse generated PSBT: %v", err)
+	}
+	if len(packet.Unknowns) != 1 {
+		t.Fatalf("expected exactly one global unknown record, got %d", len(packet.Unknowns))
+	}
+
+	unknown := packet.Unknowns[0]
+	if unknown.Key[0] != psbtGlobalXpubKeyType {
+		t.Fatalf("expected global xpub key type 0x01, got 0x%02x", unknown.Key[0])
+	}
+
+	accountKey, err := crypto.DeriveAccountKey(wallet.Seed, "m/84'/0'/0'")
+	if err != nil {
+		t.Fatalf("DeriveAccountKey failed: %v", err)
+	}
+	wantXpub, err := accountKey.PublicKey().Serialize()
+	if err != nil {
+		t.Fatalf("Serialize failed: %v", err)
+	}
+	if string(unknown.Key[1:]) != string(wantXpub) {
+		t.Error("global xpub record does not match the account's own xpub")
+	}
+
+	wantFingerprint, err := bitcoin.MasterKeyFingerprint(wallet.Seed)
+	if err != nil {
+		t.Fatalf("MasterKeyFingerprint failed: %v", err)
+	}
+	gotFingerprint := uint32(unknown.Value[0])<<24 | uint32(unknown.Value[1])<<16 |
+		uint32(unknown.Value[2])<<8 | uint32(unknown.Value[3])
+	if gotFingerprint != wantFingerprint {
+		t.Errorf("fingerprint mismatch: got %08x, want %08x", gotFingerprint, wantFingerprint)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "btc-84h-0h-0h.xprv.txt")); err == nil {
+		t.Error("xprv file should not be written unless IncludePrivate is set")
+	}
+}
+
+func TestGeneratePSBTIncludesPrivateKeyFileWhenRequested(t *testing.T) {
+	wallet := createTestWalletWithSeed(t)
+	dir := filepath.Join(t.TempDir(), "psbt")
+
+	generator := NewGenerator(types.OutputOptions{
+		Format:         types.OutputPSBT,
+		FilePath:       dir,
+		IncludePrivate: true,
+	})
+
+	if err := generator.GenerateWallet(wallet); err != nil {
+		t.Fatalf("GenerateWallet failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "btc-84h-0h-0h.xprv.txt"))
+	if err != nil {
+		t.Fatalf("expected xprv file to be written: %v", err)
+	}
+
+	accountKey, err := crypto.DeriveAccountKey(wallet.Seed, "m/84'/0'/0'")
+	if err != nil {
+		t.Fatalf("DeriveAccountKey failed: %v", err)
+	}
+	if !strings.Contains(string(data), accountKey.B58Serialize()) {
+		t.Error("xprv file does not contain the account's extended private key")
+	}
+}
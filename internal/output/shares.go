@@ -0,0 +1,117 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"anvil/internal/crypto"
+	"anvil/pkg/sss"
+	"anvil/pkg/types"
+	"github.com/skip2/go-qrcode"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// defaultShareThreshold and defaultShareTotal back a 2-of-3 Shamir split
+// when the caller doesn't configure ShareThreshold/ShareTotal, mirroring
+// generateWatchOnly's defaultRecoveryWindow fallback.
+const (
+	defaultShareThreshold = 2
+	defaultShareTotal     = 3
+)
+
+// generateShares splits the wallet's BIP39 entropy into ShareTotal Shamir
+// shares (any ShareThreshold of which reconstruct it) and writes each one
+// out as both a word-encoded text file and a QR code PNG, so the shares
+// can be distributed across separate physical locations without any
+// single one revealing the wallet.
+func (g *Generator) generateShares(wallet *types.Wallet) error {
+	if wallet.Mnemonic == "" {
+		return fmt.Errorf("share export requires the wallet mnemonic; re-run with --include-mnemonic or generate a fresh wallet")
+	}
+
+	k := g.options.ShareThreshold
+	if k <= 0 {
+		k = defaultShareThreshold
+	}
+	n := g.options.ShareTotal
+	if n <= 0 {
+		n = defaultShareTotal
+	}
+
+	entropy, err := bip39.EntropyFromMnemonic(wallet.Mnemonic)
+	if err != nil {
+		return fmt.Errorf("failed to recover entropy from mnemonic: %w", err)
+	}
+	defer crypto.ClearBytes(entropy)
+
+	shares, err := sss.Split(entropy, k, n)
+	if err != nil {
+		return fmt.Errorf("failed to split wallet entropy into shares: %w", err)
+	}
+
+	baseDir := g.options.FilePath
+	if baseDir == "" {
+		baseDir = "anvil-shares"
+	}
+	if ext := filepath.Ext(baseDir); ext != "" {
+		baseDir = strings.TrimSuffix(baseDir, ext)
+	}
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		return fmt.Errorf("failed to create shares directory: %w", err)
+	}
+
+	for _, share := range shares {
+		words, err := share.Encode()
+		if err != nil {
+			return fmt.Errorf("failed to encode share %d: %w", share.Index, err)
+		}
+
+		prefix := fmt.Sprintf("share-%d-of-%d", share.Index, n)
+
+		txtFile := filepath.Join(baseDir, prefix+".txt")
+		if err := os.WriteFile(txtFile, []byte(words+"\n"), 0644); err != nil {
+			return fmt.Errorf("failed to write share %d: %w", share.Index, err)
+		}
+
+		pngFile := filepath.Join(baseDir, prefix+".png")
+		if err := qrcode.WriteFile(words, qrcode.Medium, 256, pngFile); err != nil {
+			return fmt.Errorf("failed to generate QR code for share %d: %w", share.Index, err)
+		}
+
+		fmt.Printf("Generated share %d of %d (threshold %d): %s\n", share.Index, n, k, txtFile)
+	}
+
+	infoFile := filepath.Join(baseDir, "README.txt")
+	info := fmt.Sprintf(`ANVIL SHAMIR SECRET SHARES
+Generated: %s
+
+This directory contains %d shares of your wallet, any %d of which
+reconstruct it. No single share reveals anything about the wallet.
+
+FILES:
+`, time.Now().Format("2006-01-02 15:04:05"), n, k)
+
+	for _, share := range shares {
+		prefix := fmt.Sprintf("share-%d-of-%d", share.Index, n)
+		info += fmt.Sprintf("• %s.txt / %s.png - share %d of %d\n", prefix, prefix, share.Index, n)
+	}
+
+	info += `
+SECURITY WARNINGS:
+• Store each share at a separate physical location
+• Collecting fewer than the threshold reveals nothing about the wallet,
+  but collecting the threshold or more reconstructs it completely
+• Never photograph, scan, or upload more than one share to the same place
+• Treat every share like it could become the wallet itself
+`
+
+	if err := os.WriteFile(infoFile, []byte(info), 0644); err != nil {
+		return fmt.Errorf("failed to write info file: %w", err)
+	}
+
+	fmt.Printf("Shares saved to directory: %s\n", baseDir)
+	return nil
+}
@@ -0,0 +1,102 @@
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"anvil/pkg/sss"
+	"anvil/pkg/types"
+	"github.com/tyler-smith/go-bip39"
+)
+
+func createTestWalletWithMnemonic(t *testing.T) *types.Wallet {
+	t.Helper()
+	entropy, err := bip39.NewEntropy(128)
+	if err != nil {
+		t.Fatalf("NewEntropy failed: %v", err)
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		t.Fatalf("NewMnemonic failed: %v", err)
+	}
+
+	wallet := createTestWallet()
+	wallet.Mnemonic = mnemonic
+	return wallet
+}
+
+func TestGenerateSharesRequiresMnemonic(t *testing.T) {
+	wallet := createTestWallet()
+	dir := t.TempDir()
+
+	generator := NewGenerator(types.OutputOptions{
+		Format:   types.OutputShares,
+		FilePath: filepath.Join(dir, "shares"),
+	})
+
+	if err := generator.GenerateWallet(wallet); err == nil {
+		t.Error("expected an error when the wallet has no mnemonic")
+	}
+}
+
+func TestGenerateSharesWritesReconstructableShares(t *testing.T) {
+	wallet := createTestWalletWithMnemonic(t)
+	dir := filepath.Join(t.TempDir(), "shares")
+
+	generator := NewGenerator(types.OutputOptions{
+		Format:         types.OutputShares,
+		FilePath:       dir,
+		ShareThreshold: 2,
+		ShareTotal:     3,
+	})
+
+	if err := generator.GenerateWallet(wallet); err != nil {
+		t.Fatalf("GenerateWallet failed: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read shares directory: %v", err)
+	}
+	wantFiles := map[string]bool{
+		"share-1-of-3.txt": false, "share-1-of-3.png": false,
+		"share-2-of-3.txt": false, "share-2-of-3.png": false,
+		"share-3-of-3.txt": false, "share-3-of-3.png": false,
+		"README.txt": false,
+	}
+	for _, e := range entries {
+		wantFiles[e.Name()] = true
+	}
+	for name, found := range wantFiles {
+		if !found {
+			t.Errorf("expected %s to be written", name)
+		}
+	}
+
+	var shares []sss.Share
+	for i := 1; i <= 3; i++ {
+		words, err := os.ReadFile(filepath.Join(dir, "share-"+strconv.Itoa(i)+"-of-3.txt"))
+		if err != nil {
+			t.Fatalf("failed to read share %d: %v", i, err)
+		}
+		share, err := sss.ParseShare(string(words))
+		if err != nil {
+			t.Fatalf("failed to parse share %d: %v", i, err)
+		}
+		shares = append(shares, share)
+	}
+
+	entropy, err := sss.Combine(shares[:2])
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	reconstructed, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		t.Fatalf("NewMnemonic from reconstructed entropy failed: %v", err)
+	}
+	if reconstructed != wallet.Mnemonic {
+		t.Error("reconstructed mnemonic does not match the original")
+	}
+}
@@ -0,0 +1,238 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"anvil/internal/bitcoin"
+	"anvil/internal/crypto"
+	"anvil/pkg/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+const defaultRecoveryWindow = 2500
+
+// generateWatchOnly emits a ready-to-run import script for every BTC/DOGE
+// account in the wallet, expanding each account's external/internal chains
+// out to RecoveryWindow addresses so a cold Anvil wallet can be loaded into a
+// watching bitcoind without ever exposing keys. The "descriptors" sub-format
+// covers only the external chain (<xpub>/0/*) over that range.
+func (g *Generator) generateWatchOnly(wallet *types.Wallet) error {
+	if len(wallet.Seed) == 0 {
+		return fmt.Errorf("watch-only export requires the wallet seed; re-run with --include-mnemonic or generate a fresh wallet")
+	}
+
+	window := g.options.RecoveryWindow
+	if window <= 0 {
+		window = defaultRecoveryWindow
+	}
+
+	subFormat := g.options.WatchOnlyFormat
+	if subFormat == "" {
+		subFormat = "bitcoin-cli"
+	}
+
+	accounts, err := btcAccountPrefixes(wallet.Accounts)
+	if err != nil {
+		return err
+	}
+	if len(accounts) == 0 {
+		return fmt.Errorf("no BTC/DOGE accounts found to export as watch-only")
+	}
+
+	var buf strings.Builder
+	switch subFormat {
+	case "bitcoin-cli":
+		err = writeBitcoinCLIScript(&buf, wallet.Seed, accounts, window)
+	case "bitcoin-importwallet":
+		err = writeImportWalletDump(&buf, wallet.Seed, accounts, window)
+	case "descriptors":
+		err = writeDescriptorsJSON(&buf, wallet.Seed, accounts, window)
+	default:
+		return fmt.Errorf("unsupported --watchonly-format: %s", subFormat)
+	}
+	if err != nil {
+		return err
+	}
+
+	return g.writeOutput([]byte(buf.String()), watchOnlyExt(subFormat))
+}
+
+func watchOnlyExt(subFormat string) string {
+	if subFormat == "descriptors" {
+		return "json"
+	}
+	return "sh"
+}
+
+// accountPrefix is an account-level derivation path (e.g. "m/44'/0'/0'")
+// paired with the coin that owns it.
+type accountPrefix struct {
+	path   string
+	symbol string
+	coin   *bitcoin.BitcoinCoin
+}
+
+// btcAccountPrefixes collects the unique account-level path prefixes for
+// every BTC/DOGE account in the wallet.
+func btcAccountPrefixes(accounts []types.Account) ([]accountPrefix, error) {
+	seen := map[string]bool{}
+	var prefixes []accountPrefix
+
+	for _, acc := range accounts {
+		var coin *bitcoin.BitcoinCoin
+		switch acc.Symbol {
+		case "BTC":
+			coin = bitcoin.NewBitcoin()
+		case "DOGE":
+			coin = bitcoin.NewDogecoin()
+		default:
+			continue
+		}
+
+		prefix, err := accountLevelPath(acc.Path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive account prefix from %s: %w", acc.Path, err)
+		}
+		if seen[acc.Symbol+prefix] {
+			continue
+		}
+		seen[acc.Symbol+prefix] = true
+
+		prefixes = append(prefixes, accountPrefix{path: prefix, symbol: acc.Symbol, coin: coin})
+	}
+
+	return prefixes, nil
+}
+
+// accountLevelPath truncates a full BIP44 path (m/44'/0'/0'/0/0) down to its
+// account-level prefix (m/44'/0'/0').
+func accountLevelPath(path string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(path, "m/"), "/")
+	if len(parts) < 3 {
+		return "", fmt.Errorf("path %q has fewer than 3 components", path)
+	}
+	return "m/" + strings.Join(parts[:3], "/"), nil
+}
+
+// expandAddresses derives the first count external (change=0) and count
+// internal (change=1) addresses for the given account prefix.
+func expandAddresses(seed []byte, acct accountPrefix, count int) (external, internal []string, err error) {
+	derive := func(change, index int) (string, error) {
+		path := fmt.Sprintf("%s/%d/%d", acct.path, change, index)
+		key, err := crypto.DeriveKey(seed, path)
+		if err != nil {
+			return "", fmt.Errorf("failed to derive %s: %w", path, err)
+		}
+		_, pubKey := btcec.PrivKeyFromBytes(key.Key)
+		return acct.coin.AddressForPath(pubKey.SerializeCompressed(), path)
+	}
+
+	for i := 0; i < count; i++ {
+		addr, err := derive(0, i)
+		if err != nil {
+			return nil, nil, err
+		}
+		external = append(external, addr)
+	}
+	for i := 0; i < count; i++ {
+		addr, err := derive(1, i)
+		if err != nil {
+			return nil, nil, err
+		}
+		internal = append(internal, addr)
+	}
+	return external, internal, nil
+}
+
+// writeBitcoinCLIScript emits `bitcoin-cli importaddress` lines, one per
+// derived address, with a leading --rescan-from comment.
+func writeBitcoinCLIScript(buf *strings.Builder, seed []byte, accounts []accountPrefix, window int) error {
+	buf.WriteString("#!/usr/bin/env bash\n")
+	buf.WriteString("# Generated by anvil - import watch-only addresses into bitcoind\n")
+	buf.WriteString("# --rescan-from 0 (adjust to your wallet's birthday height)\n\n")
+
+	for _, acct := range accounts {
+		external, internal, err := expandAddresses(seed, acct, window)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(fmt.Sprintf("# %s account %s\n", acct.symbol, acct.path))
+		for _, addr := range append(external, internal...) {
+			buf.WriteString(fmt.Sprintf("bitcoin-cli importaddress %q \"\" false\n", addr))
+		}
+	}
+	buf.WriteString("\nbitcoin-cli rescanblockchain 0\n")
+	return nil
+}
+
+// writeImportWalletDump emits a `bitcoin-cli importwallet`-compatible dump
+// file with one commented address per line.
+func writeImportWalletDump(buf *strings.Builder, seed []byte, accounts []accountPrefix, window int) error {
+	buf.WriteString("# Wallet dump created by anvil (watch-only, no keys)\n")
+	buf.WriteString("# Format: label\n")
+
+	for _, acct := range accounts {
+		external, internal, err := expandAddresses(seed, acct, window)
+		if err != nil {
+			return err
+		}
+		for _, addr := range append(external, internal...) {
+			buf.WriteString(fmt.Sprintf("# addr=%s\n", addr))
+			buf.WriteString("1970-01-01T00:00:01Z label=anvil\n")
+		}
+	}
+	return nil
+}
+
+// writeDescriptorsJSON emits a `bitcoin-cli importdescriptors` compatible
+// JSON array, one descriptor per account, wrapping each account's xpub in
+// the output script function its purpose implies (pkh/sh(wpkh)/wpkh/tr).
+func writeDescriptorsJSON(buf *strings.Builder, seed []byte, accounts []accountPrefix, window int) error {
+	buf.WriteString("[\n")
+
+	for i, acct := range accounts {
+		xpub, err := acct.coin.DeriveDescriptorXpub(seed, acct.path)
+		if err != nil {
+			return fmt.Errorf("failed to derive xpub for %s: %w", acct.path, err)
+		}
+
+		desc := withChecksum(descriptorFunc(acct.path)(xpub))
+
+		buf.WriteString("  {\n")
+		buf.WriteString(fmt.Sprintf("    \"desc\": %q,\n", desc))
+		buf.WriteString("    \"timestamp\": \"now\",\n")
+		buf.WriteString(fmt.Sprintf("    \"range\": [0, %d],\n", window-1))
+		buf.WriteString("    \"watchonly\": true,\n")
+		buf.WriteString("    \"internal\": false,\n")
+		buf.WriteString(fmt.Sprintf("    \"label\": \"anvil-%s\"\n", acct.symbol))
+		if i == len(accounts)-1 {
+			buf.WriteString("  }\n")
+		} else {
+			buf.WriteString("  },\n")
+		}
+	}
+
+	buf.WriteString("]\n")
+	return nil
+}
+
+// descriptorFunc returns the Bitcoin Core output script descriptor wrapper
+// for an account path's purpose (pkh for legacy, sh(wpkh()) for BIP49,
+// wpkh for BIP84, tr for BIP86), wrapping "<xpub>/0/*" in it. Unrecognized
+// purposes fall back to pkh, matching DeriveAccount's legacy P2PKH
+// fallback for nonstandard paths.
+func descriptorFunc(path string) func(xpub string) string {
+	purpose, _ := bitcoin.PathPurpose(path)
+
+	switch purpose {
+	case bitcoin.PurposeNestedSegwit:
+		return func(xpub string) string { return fmt.Sprintf("sh(wpkh(%s/0/*))", xpub) }
+	case bitcoin.PurposeNativeSegwit:
+		return func(xpub string) string { return fmt.Sprintf("wpkh(%s/0/*)", xpub) }
+	case bitcoin.PurposeTaproot:
+		return func(xpub string) string { return fmt.Sprintf("tr(%s/0/*)", xpub) }
+	default:
+		return func(xpub string) string { return fmt.Sprintf("pkh(%s/0/*)", xpub) }
+	}
+}
@@ -0,0 +1,62 @@
+package output
+
+import "testing"
+
+func TestAccountLevelPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+		wantErr  bool
+	}{
+		{path: "m/44'/0'/0'/0/0", expected: "m/44'/0'/0'"},
+		{path: "m/84'/0'/1'/0/5", expected: "m/84'/0'/1'"},
+		{path: "m/44'/0'", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := accountLevelPath(tt.path)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("expected error for path %s", tt.path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", tt.path, err)
+		}
+		if got != tt.expected {
+			t.Errorf("accountLevelPath(%s) = %s, want %s", tt.path, got, tt.expected)
+		}
+	}
+}
+
+func TestDescriptorChecksum(t *testing.T) {
+	// Known-good vector from Bitcoin Core's BIP-380 test suite.
+	desc := "pkh(tpubD6NzVbkrYhZ4XHndKkuB8FTUSyYtuB7xW8HmLgxgxSkyaFsdQQeqLPCJPGbaKH8UBntKdAeFDrsVbBDa1pgQ9xYgF6aKvmXA2ZsBX4m3RPZ/0/*)"
+	checksum := descriptorChecksum(desc)
+	if len(checksum) != 8 {
+		t.Fatalf("expected 8-character checksum, got %q (%d chars)", checksum, len(checksum))
+	}
+	if checksum != "t9kjc7ce" {
+		t.Errorf("descriptorChecksum = %q, want %q", checksum, "t9kjc7ce")
+	}
+	if got := descriptorChecksum("raw(deadbeef)"); got != "89f8spxm" {
+		t.Errorf("descriptorChecksum(raw(deadbeef)) = %q, want %q", got, "89f8spxm")
+	}
+
+	// Checksum generation must be deterministic.
+	if again := descriptorChecksum(desc); again != checksum {
+		t.Errorf("checksum not deterministic: %s vs %s", checksum, again)
+	}
+
+	withCs := withChecksum(desc)
+	if withCs != desc+"#"+checksum {
+		t.Errorf("withChecksum did not append the expected suffix: %s", withCs)
+	}
+}
+
+func TestDescriptorChecksumRejectsInvalidCharacters(t *testing.T) {
+	if descriptorChecksum("pkh(ü)") != "" {
+		t.Error("expected empty checksum for descriptor containing characters outside the charset")
+	}
+}
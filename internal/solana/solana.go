@@ -6,12 +6,30 @@ import (
 	"time"
 
 	"anvil/internal/crypto"
+	"anvil/pkg/coins"
 	"anvil/pkg/types"
 	"github.com/blocto/solana-go-sdk/pkg/hdwallet"
 	solanatypes "github.com/blocto/solana-go-sdk/types"
 	"github.com/mr-tron/base58"
 )
 
+// compactU16 encodes a Solana "compact-u16" length prefix (shortvec).
+func compactU16(n int) []byte {
+	var out []byte
+	v := uint32(n)
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			out = append(out, b|0x80)
+		} else {
+			out = append(out, b)
+			break
+		}
+	}
+	return out
+}
+
 // SolanaCoin implements the types.Coin interface for Solana
 type SolanaCoin struct {
 	name     string
@@ -106,12 +124,21 @@ func (s *SolanaCoin) ValidateAddress(address string) bool {
 
 // GetStandardDerivationPaths returns common derivation paths for Solana
 func (s *SolanaCoin) GetStandardDerivationPaths() []string {
-	coinType := s.coinType
-	return []string{
-		fmt.Sprintf("m/44'/%d'/0'/0'", coinType), // Standard Solana path (hardened)
-		fmt.Sprintf("m/44'/%d'/1'/0'", coinType), // Second account
-		fmt.Sprintf("m/44'/%d'/2'/0'", coinType), // Third account
+	paths := types.Bip44Range{StartIndex: 0, Count: 3}.Expand(types.Bip44{
+		Coin:               s.coinType,
+		HardenedComponents: 4, // Solana hardens change and omits the address index level
+	})
+	standard := make([]string, len(paths))
+	for i, p := range paths {
+		standard[i] = p.ToPath()
 	}
+	return standard
+}
+
+// DeriveAccountTyped derives a new account from a structured Bip44 path,
+// rather than a free-form path string. See DeriveAccount.
+func (s *SolanaCoin) DeriveAccountTyped(seed []byte, p types.Bip44) (types.Account, error) {
+	return s.DeriveAccount(seed, p.ToPath())
 }
 
 // GetCoinType returns the BIP44 coin type for Solana
@@ -119,6 +146,12 @@ func (s *SolanaCoin) GetCoinType() uint32 {
 	return s.coinType
 }
 
+// Curve returns the elliptic curve Solana derives keys on, for
+// coins.CoinDriver capability discovery.
+func (s *SolanaCoin) Curve() coins.Curve {
+	return coins.CurveEd25519
+}
+
 // GetNetwork returns the network name (mainnet-beta, testnet, devnet)
 func (s *SolanaCoin) GetNetwork() string {
 	return s.network
@@ -134,7 +167,35 @@ func (s *SolanaCoin) PublicKeyFromPrivate(privateKey ed25519.PrivateKey) ed25519
 	return privateKey.Public().(ed25519.PublicKey)
 }
 
+// SignRawTransaction signs a serialized Solana message with an ed25519
+// private key and prepends the signature in wire format: a compact-u16
+// signature count followed by each 64-byte signature and then the message
+// bytes, ready to broadcast. chainParams is unused for Solana. Implements
+// types.TransactionSigner.
+func (s *SolanaCoin) SignRawTransaction(privKey []byte, rawTx []byte, chainParams any) ([]byte, error) {
+	if len(privKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("solana: private key must be %d bytes, got %d", ed25519.PrivateKeySize, len(privKey))
+	}
+
+	signature := ed25519.Sign(ed25519.PrivateKey(privKey), rawTx)
+
+	signed := make([]byte, 0, len(compactU16(1))+len(signature)+len(rawTx))
+	signed = append(signed, compactU16(1)...)
+	signed = append(signed, signature...)
+	signed = append(signed, rawTx...)
+
+	return signed, nil
+}
+
 // AddressFromPublicKey converts a public key to a Solana address
 func (s *SolanaCoin) AddressFromPublicKey(publicKey ed25519.PublicKey) string {
+	return AddressFromPublicKey(publicKey)
+}
+
+// AddressFromPublicKey converts a raw ed25519 public key to its base58 Solana
+// address. It is exported at package level so hardware-wallet-derived public
+// keys (see internal/hardware) can be turned into the same address format as
+// seed-derived accounts without needing a *SolanaCoin instance.
+func AddressFromPublicKey(publicKey ed25519.PublicKey) string {
 	return base58.Encode(publicKey)
 }
@@ -0,0 +1,107 @@
+// Package sui implements the types.Coin interface for Sui, deriving
+// ed25519 keys via SLIP-0010 (see internal/crypto.DeriveKeyEd25519) since
+// Sui, like Solana and Aptos, has no secp256k1 BIP32 derivation to reuse.
+package sui
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"anvil/internal/crypto"
+	"anvil/pkg/coins"
+	"anvil/pkg/types"
+	"golang.org/x/crypto/blake2b"
+)
+
+// ed25519Flag is Sui's signature-scheme flag byte for ed25519, prepended to
+// the public key before hashing to form an address.
+const ed25519Flag byte = 0x00
+
+// SuiCoin implements the types.Coin interface for Sui
+type SuiCoin struct {
+	name     string
+	symbol   string
+	coinType uint32
+}
+
+// NewSui creates a new Sui coin instance
+func NewSui() *SuiCoin {
+	return &SuiCoin{
+		name:     "Sui",
+		symbol:   "SUI",
+		coinType: 784, // BIP44 coin type for Sui
+	}
+}
+
+// Name returns the full name of the cryptocurrency
+func (s *SuiCoin) Name() string {
+	return s.name
+}
+
+// Symbol returns the symbol/ticker of the cryptocurrency
+func (s *SuiCoin) Symbol() string {
+	return s.symbol
+}
+
+// DeriveAccount derives a new account for the given seed and derivation path
+func (s *SuiCoin) DeriveAccount(seed []byte, path string) (types.Account, error) {
+	key, err := crypto.DeriveKeyEd25519(seed, path)
+	if err != nil {
+		return types.Account{}, fmt.Errorf("failed to derive ed25519 key: %w", err)
+	}
+
+	publicKey := key.PublicKey()
+	address := AddressFromPublicKey(publicKey)
+
+	account := types.Account{
+		Path:       path,
+		PrivateKey: key.PrivateKey(),
+		PublicKey:  publicKey,
+		Address:    address,
+		Symbol:     s.symbol,
+		CreatedAt:  time.Now(),
+	}
+
+	crypto.SecureZeroMemory(key.Key)
+
+	return account, nil
+}
+
+// AddressFromPublicKey derives a Sui address from an ed25519 public key:
+// blake2b-256(flag || publicKey), hex-encoded with a 0x prefix.
+func AddressFromPublicKey(publicKey ed25519.PublicKey) string {
+	data := append([]byte{ed25519Flag}, publicKey...)
+	hash := blake2b.Sum256(data)
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+// ValidateAddress checks if an address is a valid Sui address
+func (s *SuiCoin) ValidateAddress(address string) bool {
+	if len(address) != 66 || address[:2] != "0x" {
+		return false
+	}
+	_, err := hex.DecodeString(address[2:])
+	return err == nil
+}
+
+// GetStandardDerivationPaths returns common derivation paths for Sui
+func (s *SuiCoin) GetStandardDerivationPaths() []string {
+	return []string{
+		fmt.Sprintf("m/44'/%d'/0'/0'/0'", s.coinType), // Standard Sui path (hardened)
+		fmt.Sprintf("m/44'/%d'/1'/0'/0'", s.coinType), // Second account
+		fmt.Sprintf("m/44'/%d'/2'/0'/0'", s.coinType), // Third account
+	}
+}
+
+// Curve returns the elliptic curve Sui derives keys on, for
+// coins.CoinDriver capability discovery.
+func (s *SuiCoin) Curve() coins.Curve {
+	return coins.CurveEd25519
+}
+
+// GetCoinType returns the BIP44 coin type for Sui
+func (s *SuiCoin) GetCoinType() uint32 {
+	return s.coinType
+}
@@ -0,0 +1,57 @@
+package sui
+
+import (
+	"strings"
+	"testing"
+
+	"anvil/internal/crypto"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestSuiAddressGeneration(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed(testMnemonic, "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	sui := NewSui()
+	account, err := sui.DeriveAccount(seed, "m/44'/784'/0'/0'/0'")
+	if err != nil {
+		t.Fatalf("DeriveAccount returned error: %v", err)
+	}
+
+	if !strings.HasPrefix(account.Address, "0x") || len(account.Address) != 66 {
+		t.Errorf("expected a 0x-prefixed 32-byte address, got %s", account.Address)
+	}
+	if account.Symbol != "SUI" {
+		t.Errorf("expected symbol SUI, got %s", account.Symbol)
+	}
+	if !sui.ValidateAddress(account.Address) {
+		t.Errorf("expected derived address %s to validate", account.Address)
+	}
+}
+
+func TestSuiValidateAddress(t *testing.T) {
+	if NewSui().ValidateAddress("not-an-address") {
+		t.Error("expected an invalid address to fail validation")
+	}
+}
+
+func TestSuiGetStandardDerivationPaths(t *testing.T) {
+	paths := NewSui().GetStandardDerivationPaths()
+	expected := []string{
+		"m/44'/784'/0'/0'/0'",
+		"m/44'/784'/1'/0'/0'",
+		"m/44'/784'/2'/0'/0'",
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected %d paths, got %d", len(expected), len(paths))
+	}
+	for i, p := range paths {
+		if p != expected[i] {
+			t.Errorf("paths[%d] = %s, want %s", i, p, expected[i])
+		}
+	}
+}
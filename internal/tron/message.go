@@ -0,0 +1,55 @@
+package tron
+
+import (
+	"fmt"
+	"strconv"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// tip191Hash hashes message per TIP-191 (TRON's EIP-191 equivalent):
+// keccak256("\x19TRON Signed Message:\n" || len(message) || message).
+func tip191Hash(message []byte) []byte {
+	prefix := "\x19TRON Signed Message:\n" + strconv.Itoa(len(message))
+	return ethcrypto.Keccak256([]byte(prefix), message)
+}
+
+// Sign signs message under the TIP-191 personal-message convention and
+// returns a 65-byte r||s||v recoverable signature. Implements types.Signer.
+func (t *TronCoin) Sign(privateKey, message []byte) ([]byte, error) {
+	key, err := ethcrypto.ToECDSA(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key: %w", err)
+	}
+
+	signature, err := ethcrypto.Sign(tip191Hash(message), key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message: %w", err)
+	}
+	return signature, nil
+}
+
+// Verify reports whether sig is a valid TIP-191 personal-message signature
+// of message by address. Implements types.Signer.
+func (t *TronCoin) Verify(address string, message, sig []byte) (bool, error) {
+	recovered, err := t.RecoverAddress(message, sig)
+	if err != nil {
+		return false, err
+	}
+	return recovered == address, nil
+}
+
+// RecoverAddress recovers the signing TRON address from a TIP-191 personal
+// message signature. Implements types.Signer.
+func (t *TronCoin) RecoverAddress(message, sig []byte) (string, error) {
+	if len(sig) != 65 {
+		return "", fmt.Errorf("invalid signature length: expected 65 bytes, got %d", len(sig))
+	}
+
+	publicKey, err := ethcrypto.SigToPub(tip191Hash(message), sig)
+	if err != nil {
+		return "", fmt.Errorf("failed to recover public key: %w", err)
+	}
+
+	return AddressFromPublicKey(ethcrypto.FromECDSAPub(publicKey))
+}
@@ -0,0 +1,56 @@
+package tron
+
+import (
+	"testing"
+
+	"anvil/internal/crypto"
+)
+
+func TestTronSignVerifyRecover(t *testing.T) {
+	trx := NewTron()
+
+	seed, err := crypto.MnemonicToSeed(
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	account, err := trx.DeriveAccount(seed, "m/44'/195'/0'/0/0")
+	if err != nil {
+		t.Fatalf("failed to derive account: %v", err)
+	}
+
+	message := []byte("hello anvil")
+	sig, err := trx.Sign(account.PrivateKey, message)
+	if err != nil {
+		t.Fatalf("Sign returned error: %v", err)
+	}
+	if len(sig) != 65 {
+		t.Fatalf("expected 65-byte signature, got %d bytes", len(sig))
+	}
+
+	recovered, err := trx.RecoverAddress(message, sig)
+	if err != nil {
+		t.Fatalf("RecoverAddress returned error: %v", err)
+	}
+	if recovered != account.Address {
+		t.Errorf("recovered address %s, want %s", recovered, account.Address)
+	}
+
+	valid, err := trx.Verify(account.Address, message, sig)
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	if !valid {
+		t.Error("expected Verify to return true for a valid signature")
+	}
+
+	valid, err = trx.Verify(account.Address, []byte("tampered message"), sig)
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	if valid {
+		t.Error("expected Verify to return false for a tampered message")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"anvil/internal/crypto"
+	"anvil/pkg/coins"
 	"anvil/pkg/types"
 	"github.com/btcsuite/btcd/btcutil/base58"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
@@ -44,72 +45,100 @@ func (t *TronCoin) DeriveAccount(seed []byte, path string) (types.Account, error
 	if err != nil {
 		return types.Account{}, fmt.Errorf("failed to derive key: %w", err)
 	}
-	
+
 	// Get the private key bytes
 	privateKeyBytes := key.Key
-	
+
 	// Create ECDSA private key from bytes (same as Ethereum)
 	privateKey, err := ethcrypto.ToECDSA(privateKeyBytes)
 	if err != nil {
 		return types.Account{}, fmt.Errorf("failed to create ECDSA key: %w", err)
 	}
-	
+
 	// Get uncompressed public key bytes (65 bytes)
 	publicKeyBytes := ethcrypto.FromECDSAPub(&privateKey.PublicKey)
-	
+
 	// Generate TRON address from public key
 	address, err := t.publicKeyToAddress(publicKeyBytes)
 	if err != nil {
 		return types.Account{}, fmt.Errorf("failed to generate address: %w", err)
 	}
-	
+
 	account := types.Account{
 		Path:       path,
-		PrivateKey: privateKeyBytes,
+		PrivateKey: ethcrypto.FromECDSA(privateKey),
 		PublicKey:  publicKeyBytes,
 		Address:    address,
 		Symbol:     t.symbol,
 		CreatedAt:  time.Now(),
 	}
-	
+
 	// Clear sensitive key data
 	crypto.SecureZeroMemory(privateKeyBytes)
-	
+
 	return account, nil
 }
 
 // publicKeyToAddress converts an uncompressed public key to a TRON address
 func (t *TronCoin) publicKeyToAddress(publicKeyBytes []byte) (string, error) {
+	return AddressFromPublicKey(publicKeyBytes)
+}
+
+// AddressFromPublicKey converts an uncompressed secp256k1 public key to a TRON
+// address. It is exported so both the software derivation path above and
+// hardware-wallet-derived public keys (see internal/hardware) can produce an
+// identical TRON address from the same key material.
+func AddressFromPublicKey(publicKeyBytes []byte) (string, error) {
 	// Remove the 0x04 prefix if present (uncompressed key indicator)
 	if len(publicKeyBytes) == 65 && publicKeyBytes[0] == 0x04 {
 		publicKeyBytes = publicKeyBytes[1:]
 	}
-	
+
 	// Hash the public key with Keccak256 (same as Ethereum)
 	hash := ethcrypto.Keccak256(publicKeyBytes)
-	
+
 	// Take the last 20 bytes as the address
 	addressBytes := hash[12:]
-	
+
 	// Add TRON prefix (0x41) to make it 21 bytes
 	tronAddressBytes := make([]byte, 21)
 	tronAddressBytes[0] = 0x41 // TRON mainnet prefix
 	copy(tronAddressBytes[1:], addressBytes)
-	
+
 	// TRON uses double SHA256 for checksum, not Bitcoin's CheckEncode
 	checksum := doubleSHA256(tronAddressBytes)
-	
+
 	// Append first 4 bytes of checksum
 	addressWithChecksum := make([]byte, 25)
 	copy(addressWithChecksum[:21], tronAddressBytes)
 	copy(addressWithChecksum[21:], checksum[:4])
-	
+
 	// Encode with Base58 (no version byte)
 	address := base58.Encode(addressWithChecksum)
-	
+
 	return address, nil
 }
 
+// SignRawTransaction signs the raw_data bytes of a TRON TransactionRaw
+// protobuf message: txID = SHA256(raw_data), signed with a recoverable
+// secp256k1 signature over txID exactly as tronweb/wallet-cli do. chainParams
+// is unused for TRON. Implements types.TransactionSigner.
+func (t *TronCoin) SignRawTransaction(privKey []byte, rawTx []byte, chainParams any) ([]byte, error) {
+	privateKey, err := ethcrypto.ToECDSA(privKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key: %w", err)
+	}
+
+	txID := sha256.Sum256(rawTx)
+
+	signature, err := ethcrypto.Sign(txID[:], privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	return signature, nil
+}
+
 // ValidateAddress checks if an address is a valid TRON address
 func (t *TronCoin) ValidateAddress(address string) bool {
 	// Decode the Base58 address
@@ -117,16 +146,16 @@ func (t *TronCoin) ValidateAddress(address string) bool {
 	if len(decoded) != 25 {
 		return false
 	}
-	
+
 	// Split address and checksum
 	addressBytes := decoded[:21]
 	providedChecksum := decoded[21:]
-	
+
 	// Check TRON mainnet prefix
 	if addressBytes[0] != 0x41 {
 		return false
 	}
-	
+
 	// Verify checksum
 	expectedChecksum := doubleSHA256(addressBytes)
 	for i := 0; i < 4; i++ {
@@ -134,25 +163,37 @@ func (t *TronCoin) ValidateAddress(address string) bool {
 			return false
 		}
 	}
-	
+
 	return true
 }
 
 // GetStandardDerivationPaths returns common derivation paths for TRON
 func (t *TronCoin) GetStandardDerivationPaths() []string {
-	coinType := t.coinType
+	base := types.Bip44{Coin: t.coinType, HardenedComponents: 3}
 	return []string{
-		fmt.Sprintf("m/44'/%d'/0'/0/0", coinType),  // BIP44 standard path
-		fmt.Sprintf("m/44'/%d'/0'/0/1", coinType),  // Second address
-		fmt.Sprintf("m/44'/%d'/1'/0/0", coinType),  // Change addresses
+		base.ToPath(), // BIP44 standard path
+		types.Bip44Range{StartIndex: 1, Count: 1}.Expand(base)[0].ToPath(),        // Second address
+		types.Bip44{Coin: t.coinType, Account: 1, HardenedComponents: 3}.ToPath(), // Second account
 	}
 }
 
+// DeriveAccountTyped derives a new account from a structured Bip44 path,
+// rather than a free-form path string. See DeriveAccount.
+func (t *TronCoin) DeriveAccountTyped(seed []byte, p types.Bip44) (types.Account, error) {
+	return t.DeriveAccount(seed, p.ToPath())
+}
+
 // GetCoinType returns the BIP44 coin type for TRON
 func (t *TronCoin) GetCoinType() uint32 {
 	return t.coinType
 }
 
+// Curve returns the elliptic curve TRON derives keys on, for
+// coins.CoinDriver capability discovery.
+func (t *TronCoin) Curve() coins.Curve {
+	return coins.CurveSecp256k1
+}
+
 // AddressToHex converts a TRON Base58 address to hex format
 func (t *TronCoin) AddressToHex(address string) (string, error) {
 	// Decode the Base58 address
@@ -160,13 +201,13 @@ func (t *TronCoin) AddressToHex(address string) (string, error) {
 	if len(decoded) != 25 {
 		return "", fmt.Errorf("invalid address length")
 	}
-	
+
 	// Extract the 21-byte address (skip checksum)
 	addressBytes := decoded[:21]
 	if addressBytes[0] != 0x41 {
 		return "", fmt.Errorf("invalid TRON address prefix")
 	}
-	
+
 	// Convert to hex (remove 0x41 prefix and return as 0x...)
 	hexAddress := fmt.Sprintf("0x%x", addressBytes[1:])
 	return hexAddress, nil
@@ -178,12 +219,12 @@ func (t *TronCoin) HexToAddress(hexAddress string) (string, error) {
 	if len(hexAddress) >= 2 && hexAddress[:2] == "0x" {
 		hexAddress = hexAddress[2:]
 	}
-	
+
 	// Check hex address length (should be 40 characters = 20 bytes)
 	if len(hexAddress) != 40 {
 		return "", fmt.Errorf("invalid hex address length")
 	}
-	
+
 	// Parse hex string to bytes
 	addressBytes := make([]byte, 20)
 	for i := 0; i < 20; i++ {
@@ -192,18 +233,18 @@ func (t *TronCoin) HexToAddress(hexAddress string) (string, error) {
 			return "", fmt.Errorf("invalid hex address format: %w", err)
 		}
 	}
-	
+
 	// Add TRON prefix
 	tronAddressBytes := make([]byte, 21)
 	tronAddressBytes[0] = 0x41
 	copy(tronAddressBytes[1:], addressBytes)
-	
+
 	// Add checksum
 	checksum := doubleSHA256(tronAddressBytes)
 	addressWithChecksum := make([]byte, 25)
 	copy(addressWithChecksum[:21], tronAddressBytes)
 	copy(addressWithChecksum[21:], checksum[:4])
-	
+
 	// Encode with Base58
 	address := base58.Encode(addressWithChecksum)
 	return address, nil
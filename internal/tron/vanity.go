@@ -0,0 +1,114 @@
+package tron
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"anvil/internal/crypto"
+	"anvil/pkg/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// base58Alphabet is the size of the Base58 character set TRON addresses are
+// encoded with, used to estimate vanity search cost.
+const base58Alphabet = 58
+
+// VanityCostEstimate reports the expected number of candidate keys
+// GenerateVanityAddress has to generate before finding a matching prefix.
+type VanityCostEstimate struct {
+	Prefix           string
+	ExpectedAttempts float64
+}
+
+// EstimateVanityCost returns the expected number of random keys that must be
+// generated before a TRON address starting with "T"+prefix turns up, modeled
+// as a uniform 1-in-58^len(prefix) chance per attempt.
+func EstimateVanityCost(prefix string) VanityCostEstimate {
+	return VanityCostEstimate{
+		Prefix:           prefix,
+		ExpectedAttempts: math.Pow(base58Alphabet, float64(len(prefix))),
+	}
+}
+
+// GenerateVanityAddress searches for a TRON keypair whose address begins
+// with "T"+prefix, sharding the search across workers goroutines each
+// generating keys from crypto.SecureRandom. ctx cancels the search early; if
+// it's already done before a match is found, ctx.Err() is returned.
+// Implements types.VanityGenerator.
+func (t *TronCoin) GenerateVanityAddress(ctx context.Context, prefix string, workers int) (*types.Account, error) {
+	if workers < 1 {
+		workers = 1
+	}
+	want := "T" + prefix
+
+	searchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var found atomic.Bool
+	result := make(chan *types.Account, 1)
+	errs := make(chan error, workers)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for !found.Load() {
+				select {
+				case <-searchCtx.Done():
+					return
+				default:
+				}
+
+				privKeyBytes, err := crypto.SecureRandom(32)
+				if err != nil {
+					errs <- fmt.Errorf("failed to generate candidate key: %w", err)
+					return
+				}
+				privateKey, err := ethcrypto.ToECDSA(privKeyBytes)
+				if err != nil {
+					continue // not a valid secp256k1 scalar; try another candidate
+				}
+
+				publicKeyBytes := ethcrypto.FromECDSAPub(&privateKey.PublicKey)
+				address, err := AddressFromPublicKey(publicKeyBytes)
+				if err != nil {
+					continue
+				}
+
+				if strings.HasPrefix(address, want) && found.CompareAndSwap(false, true) {
+					cancel()
+					result <- &types.Account{
+						Path:       "vanity",
+						PrivateKey: privKeyBytes,
+						PublicKey:  publicKeyBytes,
+						Address:    address,
+						Symbol:     t.symbol,
+						CreatedAt:  time.Now(),
+					}
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(result)
+		close(errs)
+	}()
+
+	account, ok := <-result
+	if ok {
+		return account, nil
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return nil, searchCtx.Err()
+}
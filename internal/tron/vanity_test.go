@@ -0,0 +1,57 @@
+package tron
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateVanityAddressEmptyPrefixMatchesImmediately(t *testing.T) {
+	trx := NewTron()
+
+	account, err := trx.GenerateVanityAddress(context.Background(), "", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(account.Address, "T") {
+		t.Errorf("expected address starting with T, got %s", account.Address)
+	}
+	if account.Symbol != "TRX" {
+		t.Errorf("expected symbol TRX, got %s", account.Symbol)
+	}
+	if len(account.PrivateKey) != 32 {
+		t.Errorf("expected 32-byte private key, got %d bytes", len(account.PrivateKey))
+	}
+}
+
+func TestGenerateVanityAddressRespectsCancellation(t *testing.T) {
+	trx := NewTron()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := trx.GenerateVanityAddress(ctx, "ZZZZZZZZZZ", 2); err == nil {
+		t.Error("expected an error from an already-canceled context")
+	}
+}
+
+func TestEstimateVanityCost(t *testing.T) {
+	est := EstimateVanityCost("AB")
+	if est.ExpectedAttempts != 58*58 {
+		t.Errorf("expected 58^2 attempts, got %f", est.ExpectedAttempts)
+	}
+	if est.Prefix != "AB" {
+		t.Errorf("expected prefix AB, got %s", est.Prefix)
+	}
+}
+
+func TestGenerateVanityAddressTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	trx := NewTron()
+	if _, err := trx.GenerateVanityAddress(ctx, "ZZZZZZ", 2); err == nil {
+		t.Error("expected a timeout error searching for an implausible prefix")
+	}
+}
@@ -0,0 +1,143 @@
+// Package coins provides a pluggable registry of CoinDriver implementations,
+// so callers can enumerate and derive accounts for every supported chain
+// without importing each chain's package directly. Optional capability
+// interfaces (types.Signer, types.VanityGenerator, types.HexConverter) let
+// callers discover per-chain features via a type assertion on a looked-up
+// CoinDriver, instead of a type switch over concrete coin types.
+package coins
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"anvil/pkg/types"
+)
+
+// Curve identifies the elliptic curve a CoinDriver derives keys on, so a
+// generic caller (or the crypto layer) can pick DeriveKey vs DeriveKeyEd25519
+// without hard-coding a per-chain table.
+type Curve int
+
+const (
+	CurveSecp256k1 Curve = iota
+	CurveEd25519
+	CurveSr25519
+)
+
+// String returns the curve's conventional name.
+func (c Curve) String() string {
+	switch c {
+	case CurveSecp256k1:
+		return "secp256k1"
+	case CurveEd25519:
+		return "ed25519"
+	case CurveSr25519:
+		return "sr25519"
+	default:
+		return "unknown"
+	}
+}
+
+// CoinDriver is implemented by every registerable chain. It embeds
+// types.Coin for the baseline Name/Symbol/DeriveAccount, and adds the
+// metadata and address validation a generic registry needs. Coins that
+// additionally implement types.Signer, types.VanityGenerator, or
+// types.HexConverter expose those capabilities via a type assertion on the
+// CoinDriver returned by Lookup.
+type CoinDriver interface {
+	types.Coin
+	Curve() Curve
+	GetCoinType() uint32
+	ValidateAddress(address string) bool
+}
+
+// Registry maps coin names and BIP44 coin types to CoinDrivers. The zero
+// value is not usable; construct one with NewRegistry.
+type Registry struct {
+	mu         sync.RWMutex
+	byName     map[string]CoinDriver
+	byCoinType map[uint32]CoinDriver
+}
+
+// NewRegistry creates an empty coin registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		byName:     make(map[string]CoinDriver),
+		byCoinType: make(map[uint32]CoinDriver),
+	}
+}
+
+// Register adds d to the registry under name (matched case-insensitively by
+// Lookup). It panics on a duplicate name, since that indicates two drivers
+// were wired up for the same symbol - a programming error, not a runtime one.
+func (r *Registry) Register(name string, d CoinDriver) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := strings.ToUpper(name)
+	if _, exists := r.byName[key]; exists {
+		panic(fmt.Sprintf("coins: driver already registered for %q", name))
+	}
+
+	r.byName[key] = d
+	r.byCoinType[d.GetCoinType()] = d
+}
+
+// Lookup finds a CoinDriver by symbol (e.g. "ETH", case-insensitive) or by
+// BIP44 coin type (e.g. "60"). It reports false if nothing matches either.
+func (r *Registry) Lookup(nameOrCoinType string) (CoinDriver, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if d, ok := r.byName[strings.ToUpper(nameOrCoinType)]; ok {
+		return d, true
+	}
+
+	if coinType, err := strconv.ParseUint(nameOrCoinType, 10, 32); err == nil {
+		if d, ok := r.byCoinType[uint32(coinType)]; ok {
+			return d, true
+		}
+	}
+
+	return nil, false
+}
+
+// All returns every registered driver, sorted by symbol for deterministic
+// output (e.g. when listing supported chains in a CLI).
+func (r *Registry) All() []CoinDriver {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	drivers := make([]CoinDriver, 0, len(r.byName))
+	for _, d := range r.byName {
+		drivers = append(drivers, d)
+	}
+	sort.Slice(drivers, func(i, j int) bool {
+		return drivers[i].Symbol() < drivers[j].Symbol()
+	})
+	return drivers
+}
+
+// DefaultRegistry is the registry the CLI populates at startup (see
+// registerCoinDrivers in cmd/anvil and ethereum.RegisterEVMCoins); coin
+// packages do not register themselves. Most callers should use the package-level Register/Lookup/All
+// functions rather than constructing their own Registry.
+var DefaultRegistry = NewRegistry()
+
+// Register adds d to DefaultRegistry under name. See Registry.Register.
+func Register(name string, d CoinDriver) {
+	DefaultRegistry.Register(name, d)
+}
+
+// Lookup finds a CoinDriver in DefaultRegistry. See Registry.Lookup.
+func Lookup(nameOrCoinType string) (CoinDriver, bool) {
+	return DefaultRegistry.Lookup(nameOrCoinType)
+}
+
+// All returns every driver registered in DefaultRegistry. See Registry.All.
+func All() []CoinDriver {
+	return DefaultRegistry.All()
+}
@@ -0,0 +1,105 @@
+package coins
+
+import (
+	"testing"
+	"time"
+
+	"anvil/pkg/types"
+)
+
+// fakeDriver is a minimal CoinDriver used to exercise Registry without
+// depending on any concrete chain package.
+type fakeDriver struct {
+	name     string
+	symbol   string
+	coinType uint32
+	curve    Curve
+}
+
+func (f *fakeDriver) Name() string   { return f.name }
+func (f *fakeDriver) Symbol() string { return f.symbol }
+func (f *fakeDriver) DeriveAccount(seed []byte, path string) (types.Account, error) {
+	return types.Account{Path: path, Symbol: f.symbol, CreatedAt: time.Now()}, nil
+}
+func (f *fakeDriver) Curve() Curve                       { return f.curve }
+func (f *fakeDriver) GetCoinType() uint32                { return f.coinType }
+func (f *fakeDriver) ValidateAddress(address string) bool { return address != "" }
+
+func TestRegistryRegisterAndLookupByName(t *testing.T) {
+	r := NewRegistry()
+	driver := &fakeDriver{name: "Fake Coin", symbol: "FAKE", coinType: 9999, curve: CurveSecp256k1}
+	r.Register("FAKE", driver)
+
+	found, ok := r.Lookup("fake")
+	if !ok {
+		t.Fatal("expected a case-insensitive name lookup to succeed")
+	}
+	if found != driver {
+		t.Error("lookup returned a different driver than was registered")
+	}
+}
+
+func TestRegistryLookupByCoinType(t *testing.T) {
+	r := NewRegistry()
+	driver := &fakeDriver{name: "Fake Coin", symbol: "FAKE", coinType: 9999, curve: CurveSecp256k1}
+	r.Register("FAKE", driver)
+
+	found, ok := r.Lookup("9999")
+	if !ok {
+		t.Fatal("expected a lookup by coin type to succeed")
+	}
+	if found != driver {
+		t.Error("lookup returned a different driver than was registered")
+	}
+}
+
+func TestRegistryLookupMiss(t *testing.T) {
+	r := NewRegistry()
+	if _, ok := r.Lookup("NOPE"); ok {
+		t.Error("expected a lookup for an unregistered name to fail")
+	}
+}
+
+func TestRegistryRegisterDuplicatePanics(t *testing.T) {
+	r := NewRegistry()
+	driver := &fakeDriver{name: "Fake Coin", symbol: "FAKE", coinType: 9999, curve: CurveSecp256k1}
+	r.Register("FAKE", driver)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected registering a duplicate name to panic")
+		}
+	}()
+	r.Register("FAKE", driver)
+}
+
+func TestRegistryAllIsSortedBySymbol(t *testing.T) {
+	r := NewRegistry()
+	r.Register("ZCOIN", &fakeDriver{name: "Z Coin", symbol: "ZCOIN", coinType: 1, curve: CurveSecp256k1})
+	r.Register("ACOIN", &fakeDriver{name: "A Coin", symbol: "ACOIN", coinType: 2, curve: CurveEd25519})
+
+	all := r.All()
+	if len(all) != 2 {
+		t.Fatalf("expected 2 drivers, got %d", len(all))
+	}
+	if all[0].Symbol() != "ACOIN" || all[1].Symbol() != "ZCOIN" {
+		t.Errorf("expected drivers sorted by symbol, got %s then %s", all[0].Symbol(), all[1].Symbol())
+	}
+}
+
+func TestCurveString(t *testing.T) {
+	testCases := []struct {
+		curve    Curve
+		expected string
+	}{
+		{CurveSecp256k1, "secp256k1"},
+		{CurveEd25519, "ed25519"},
+		{CurveSr25519, "sr25519"},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.curve.String(); got != tc.expected {
+			t.Errorf("Curve(%d).String() = %q, want %q", tc.curve, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package keystore
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"anvil/internal/crypto"
+	"anvil/pkg/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+var _ types.KeyStore = (*FileKeyStore)(nil)
+
+// FileKeyStore persists each account as its own go-ethereum V3-compatible
+// encrypted JSON file under Dir, satisfying types.KeyStore. path, as used by
+// Load and Sign, is the absolute or relative filesystem path to that file -
+// the value KeyPath returns after a Store.
+type FileKeyStore struct {
+	Dir        string
+	Passphrase string
+}
+
+// NewFileKeyStore returns a FileKeyStore that writes under dir, encrypting
+// with passphrase.
+func NewFileKeyStore(dir, passphrase string) *FileKeyStore {
+	return &FileKeyStore{Dir: dir, Passphrase: passphrase}
+}
+
+// KeyPath returns the file Store writes account's key to.
+func (s *FileKeyStore) KeyPath(address string) string {
+	return filepath.Join(s.Dir, strings.ToLower(strings.TrimPrefix(address, "0x"))+".json")
+}
+
+// Store encrypts account.PrivateKey under Passphrase and writes it to
+// KeyPath(account.Address), creating Dir if needed. Implements
+// types.KeyStore.
+func (s *FileKeyStore) Store(account types.Account) error {
+	if len(account.PrivateKey) == 0 {
+		return fmt.Errorf("account %s has no private key to store", account.Address)
+	}
+
+	if err := os.MkdirAll(s.Dir, 0700); err != nil {
+		return fmt.Errorf("failed to create keystore directory: %w", err)
+	}
+
+	ks, err := EncryptV3(account.PrivateKey, account.Address, s.Passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt key: %w", err)
+	}
+
+	data, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal keystore: %w", err)
+	}
+	if err := os.WriteFile(s.KeyPath(account.Address), data, 0600); err != nil {
+		return fmt.Errorf("failed to write keystore file: %w", err)
+	}
+	return nil
+}
+
+// Load decrypts the keystore file at path and returns its public material.
+// Symbol is not recorded in the V3 format, so it is left empty; callers that
+// need it should track it alongside path themselves. Implements
+// types.KeyStore.
+func (s *FileKeyStore) Load(path string) (types.Account, error) {
+	ks, err := s.readV3(path)
+	if err != nil {
+		return types.Account{}, err
+	}
+
+	privateKey, err := DecryptV3(ks, s.Passphrase)
+	if err != nil {
+		return types.Account{}, err
+	}
+	defer crypto.ClearBytes(privateKey)
+
+	ecdsaKey, err := ethcrypto.ToECDSA(privateKey)
+	if err != nil {
+		return types.Account{}, fmt.Errorf("invalid private key in keystore: %w", err)
+	}
+
+	return types.Account{
+		PublicKey: ethcrypto.FromECDSAPub(&ecdsaKey.PublicKey),
+		Address:   "0x" + ks.Address,
+	}, nil
+}
+
+// Sign decrypts the key at path and signs digest with it, without ever
+// returning the private key itself. Implements types.KeyStore.
+func (s *FileKeyStore) Sign(path string, digest []byte) ([]byte, error) {
+	ks, err := s.readV3(path)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := DecryptV3(ks, s.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer crypto.ClearBytes(privateKey)
+
+	ecdsaKey, err := ethcrypto.ToECDSA(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key in keystore: %w", err)
+	}
+
+	sig, err := ethcrypto.Sign(digest, ecdsaKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign digest: %w", err)
+	}
+	return sig, nil
+}
+
+func (s *FileKeyStore) readV3(path string) (V3, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return V3{}, fmt.Errorf("failed to read keystore file: %w", err)
+	}
+
+	var ks V3
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return V3{}, fmt.Errorf("failed to parse keystore file: %w", err)
+	}
+	return ks, nil
+}
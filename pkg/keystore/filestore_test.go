@@ -0,0 +1,85 @@
+package keystore
+
+import (
+	"testing"
+
+	"anvil/pkg/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+func testAccount(t *testing.T) types.Account {
+	t.Helper()
+	priv, err := ethcrypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	return types.Account{
+		PrivateKey: ethcrypto.FromECDSA(priv),
+		PublicKey:  ethcrypto.FromECDSAPub(&priv.PublicKey),
+		Address:    ethcrypto.PubkeyToAddress(priv.PublicKey).Hex(),
+		Symbol:     "ETH",
+	}
+}
+
+func TestFileKeyStoreStoreLoadRoundTrip(t *testing.T) {
+	account := testAccount(t)
+	store := NewFileKeyStore(t.TempDir(), "correct horse battery staple")
+
+	if err := store.Store(account); err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+
+	loaded, err := store.Load(store.KeyPath(account.Address))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if string(loaded.PublicKey) != string(account.PublicKey) {
+		t.Error("loaded public key does not match the stored account's")
+	}
+}
+
+func TestFileKeyStoreLoadRejectsWrongPassphrase(t *testing.T) {
+	account := testAccount(t)
+	store := NewFileKeyStore(t.TempDir(), "correct horse battery staple")
+	if err := store.Store(account); err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+
+	wrongStore := &FileKeyStore{Dir: store.Dir, Passphrase: "wrong passphrase"}
+	if _, err := wrongStore.Load(store.KeyPath(account.Address)); err == nil {
+		t.Error("expected an error decrypting with the wrong passphrase")
+	}
+}
+
+func TestFileKeyStoreSignProducesVerifiableSignature(t *testing.T) {
+	account := testAccount(t)
+	store := NewFileKeyStore(t.TempDir(), "correct horse battery staple")
+	if err := store.Store(account); err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+
+	digest := make([]byte, 32)
+	for i := range digest {
+		digest[i] = byte(i)
+	}
+
+	sig, err := store.Sign(store.KeyPath(account.Address), digest)
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+
+	recovered, err := ethcrypto.SigToPub(digest, sig)
+	if err != nil {
+		t.Fatalf("SigToPub failed: %v", err)
+	}
+	if ethcrypto.PubkeyToAddress(*recovered).Hex() != account.Address {
+		t.Error("signature does not recover to the stored account's address")
+	}
+}
+
+func TestFileKeyStoreStoreRequiresPrivateKey(t *testing.T) {
+	store := NewFileKeyStore(t.TempDir(), "passphrase")
+	if err := store.Store(types.Account{Address: "0xdead"}); err == nil {
+		t.Error("expected an error storing an account with no private key")
+	}
+}
@@ -0,0 +1,242 @@
+package keystore
+
+import (
+	"fmt"
+
+	"anvil/pkg/types"
+	"github.com/miekg/pkcs11"
+)
+
+var _ types.KeyStore = (*PKCS11KeyStore)(nil)
+
+// secp256k1ECParams is the DER encoding of the secp256k1 OID
+// (1.3.132.0.10), the curve every Anvil coin currently derives keys on.
+// PKCS#11 identifies curves by this ASN.1 blob rather than a named
+// constant.
+var secp256k1ECParams = []byte{0x06, 0x05, 0x2B, 0x81, 0x04, 0x00, 0x0A}
+
+// pkcs11Ctx is the subset of *pkcs11.Ctx that PKCS11KeyStore uses, narrowed
+// to an interface so tests can exercise the store against a fake token
+// instead of a real PKCS#11 module.
+type pkcs11Ctx interface {
+	Initialize() error
+	Finalize() error
+	GetSlotList(tokenPresent bool) ([]uint, error)
+	OpenSession(slotID uint, flags uint) (pkcs11.SessionHandle, error)
+	CloseSession(sh pkcs11.SessionHandle) error
+	Login(sh pkcs11.SessionHandle, userType uint, pin string) error
+	Logout(sh pkcs11.SessionHandle) error
+	CreateObject(sh pkcs11.SessionHandle, temp []*pkcs11.Attribute) (pkcs11.ObjectHandle, error)
+	FindObjectsInit(sh pkcs11.SessionHandle, temp []*pkcs11.Attribute) error
+	FindObjects(sh pkcs11.SessionHandle, max int) ([]pkcs11.ObjectHandle, bool, error)
+	FindObjectsFinal(sh pkcs11.SessionHandle) error
+	GetAttributeValue(sh pkcs11.SessionHandle, o pkcs11.ObjectHandle, a []*pkcs11.Attribute) ([]*pkcs11.Attribute, error)
+	SignInit(sh pkcs11.SessionHandle, m []*pkcs11.Mechanism, o pkcs11.ObjectHandle) error
+	Sign(sh pkcs11.SessionHandle, message []byte) ([]byte, error)
+}
+
+// PKCS11KeyStore drives a hardware token (YubiKey, Nitrokey, SoftHSM, or any
+// other PKCS#11 v2.40 compliant module) to hold account private keys,
+// satisfying types.KeyStore. Unlike FileKeyStore, the private key is never
+// readable by Anvil once Stored: Load only returns the public key, and Sign
+// asks the token to produce a signature over a digest it already holds the
+// key for. path is the CKA_LABEL the key was provisioned under (typically
+// the account address), not a filesystem path.
+//
+// Only secp256k1 EC keys are supported, matching the curve Anvil's Bitcoin
+// and Ethereum-family coins derive on; ed25519 accounts cannot be stored
+// here since PKCS#11 v2.40 has no EdDSA mechanism.
+type PKCS11KeyStore struct {
+	ctx    pkcs11Ctx
+	slot   uint
+	pin    string
+	closed bool
+}
+
+// OpenPKCS11KeyStore loads the PKCS#11 module at modulePath, selects the
+// first slot with a token present, and keeps pin for later use. No session
+// is held open: each Store, Load, or Sign call opens its own session, logs
+// in with pin, and closes it again. Callers must call Close when done to
+// finalize the module.
+func OpenPKCS11KeyStore(modulePath, pin string) (*PKCS11KeyStore, error) {
+	ctx := pkcs11.New(modulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load PKCS#11 module %q", modulePath)
+	}
+
+	store, err := newPKCS11KeyStore(ctx, pin)
+	if err != nil {
+		ctx.Destroy()
+		return nil, err
+	}
+	return store, nil
+}
+
+func newPKCS11KeyStore(ctx pkcs11Ctx, pin string) (*PKCS11KeyStore, error) {
+	if err := ctx.Initialize(); err != nil {
+		return nil, fmt.Errorf("failed to initialize PKCS#11 module: %w", err)
+	}
+
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PKCS#11 slots: %w", err)
+	}
+	if len(slots) == 0 {
+		return nil, fmt.Errorf("no PKCS#11 token present")
+	}
+
+	return &PKCS11KeyStore{ctx: ctx, slot: slots[0], pin: pin}, nil
+}
+
+// Close finalizes the PKCS#11 module. It does not unload the module's
+// library; calling it again is a no-op.
+func (s *PKCS11KeyStore) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return s.ctx.Finalize()
+}
+
+func (s *PKCS11KeyStore) session() (pkcs11.SessionHandle, error) {
+	sh, err := s.ctx.OpenSession(s.slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open PKCS#11 session: %w", err)
+	}
+	if err := s.ctx.Login(sh, pkcs11.CKU_USER, s.pin); err != nil {
+		s.ctx.CloseSession(sh)
+		return 0, fmt.Errorf("failed to log in to token: %w", err)
+	}
+	return sh, nil
+}
+
+func (s *PKCS11KeyStore) closeSession(sh pkcs11.SessionHandle) {
+	s.ctx.Logout(sh)
+	s.ctx.CloseSession(sh)
+}
+
+// Store imports account.PrivateKey onto the token as a non-extractable EC
+// private key object labeled with account.Address, alongside the matching
+// public key object so Load can find it back. The key never exists as a
+// file or in Anvil's own memory once this returns. Implements
+// types.KeyStore.
+func (s *PKCS11KeyStore) Store(account types.Account) error {
+	if len(account.PrivateKey) == 0 {
+		return fmt.Errorf("account %s has no private key to store", account.Address)
+	}
+	if len(account.PublicKey) == 0 {
+		return fmt.Errorf("account %s has no public key to store alongside it", account.Address)
+	}
+
+	sh, err := s.session()
+	if err != nil {
+		return err
+	}
+	defer s.closeSession(sh)
+
+	label := account.Address
+
+	privTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, secp256k1ECParams),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, account.PrivateKey),
+	}
+	if _, err := s.ctx.CreateObject(sh, privTemplate); err != nil {
+		return fmt.Errorf("failed to provision private key on token: %w", err)
+	}
+
+	pubTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, secp256k1ECParams),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, account.PublicKey),
+	}
+	if _, err := s.ctx.CreateObject(sh, pubTemplate); err != nil {
+		return fmt.Errorf("failed to provision public key on token: %w", err)
+	}
+
+	return nil
+}
+
+// Load finds the public key object labeled path and returns its public
+// material. Implements types.KeyStore.
+func (s *PKCS11KeyStore) Load(path string) (types.Account, error) {
+	sh, err := s.session()
+	if err != nil {
+		return types.Account{}, err
+	}
+	defer s.closeSession(sh)
+
+	obj, err := s.findObject(sh, pkcs11.CKO_PUBLIC_KEY, path)
+	if err != nil {
+		return types.Account{}, err
+	}
+
+	attrs, err := s.ctx.GetAttributeValue(sh, obj, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return types.Account{}, fmt.Errorf("failed to read public key from token: %w", err)
+	}
+
+	return types.Account{
+		PublicKey: attrs[0].Value,
+		Address:   path,
+	}, nil
+}
+
+// Sign asks the token to sign digest with the private key labeled path,
+// using plain ECDSA (no additional hashing - digest is assumed already
+// hashed). Implements types.KeyStore.
+func (s *PKCS11KeyStore) Sign(path string, digest []byte) ([]byte, error) {
+	sh, err := s.session()
+	if err != nil {
+		return nil, err
+	}
+	defer s.closeSession(sh)
+
+	obj, err := s.findObject(sh, pkcs11.CKO_PRIVATE_KEY, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ctx.SignInit(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}, obj); err != nil {
+		return nil, fmt.Errorf("failed to initialize signing on token: %w", err)
+	}
+
+	sig, err := s.ctx.Sign(sh, digest)
+	if err != nil {
+		return nil, fmt.Errorf("token signing failed: %w", err)
+	}
+	return sig, nil
+}
+
+func (s *PKCS11KeyStore) findObject(sh pkcs11.SessionHandle, class uint, label string) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := s.ctx.FindObjectsInit(sh, template); err != nil {
+		return 0, fmt.Errorf("failed to search token objects: %w", err)
+	}
+	defer s.ctx.FindObjectsFinal(sh)
+
+	objs, _, err := s.ctx.FindObjects(sh, 1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to search token objects: %w", err)
+	}
+	if len(objs) == 0 {
+		return 0, fmt.Errorf("no key labeled %q found on token", label)
+	}
+	return objs[0], nil
+}
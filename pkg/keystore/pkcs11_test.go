@@ -0,0 +1,201 @@
+package keystore
+
+import (
+	"bytes"
+	"testing"
+
+	"anvil/pkg/types"
+	"github.com/miekg/pkcs11"
+)
+
+// fakePKCS11 is a minimal in-memory stand-in for a real token, modeled on
+// internal/hardware's fakeTransport: just enough object/session bookkeeping
+// to exercise PKCS11KeyStore's flow without real hardware attached.
+type fakePKCS11 struct {
+	objects       []fakeObject
+	nextHandle    pkcs11.ObjectHandle
+	findResults   []pkcs11.ObjectHandle
+	signObject    pkcs11.ObjectHandle
+	signature     []byte
+	loggedIn      bool
+	wrongPIN      string
+	signedDigests [][]byte
+}
+
+type fakeObject struct {
+	handle pkcs11.ObjectHandle
+	attrs  map[uint][]byte
+}
+
+func (f *fakePKCS11) Initialize() error { return nil }
+func (f *fakePKCS11) Finalize() error   { return nil }
+
+func (f *fakePKCS11) GetSlotList(tokenPresent bool) ([]uint, error) {
+	return []uint{0}, nil
+}
+
+func (f *fakePKCS11) OpenSession(slotID uint, flags uint) (pkcs11.SessionHandle, error) {
+	return pkcs11.SessionHandle(1), nil
+}
+
+func (f *fakePKCS11) CloseSession(sh pkcs11.SessionHandle) error { return nil }
+
+func (f *fakePKCS11) Login(sh pkcs11.SessionHandle, userType uint, pin string) error {
+	if f.wrongPIN != "" && pin == f.wrongPIN {
+		return errInvalidPIN
+	}
+	f.loggedIn = true
+	return nil
+}
+
+func (f *fakePKCS11) Logout(sh pkcs11.SessionHandle) error { return nil }
+
+func (f *fakePKCS11) CreateObject(sh pkcs11.SessionHandle, temp []*pkcs11.Attribute) (pkcs11.ObjectHandle, error) {
+	f.nextHandle++
+	obj := fakeObject{handle: f.nextHandle, attrs: map[uint][]byte{}}
+	for _, a := range temp {
+		obj.attrs[a.Type] = a.Value
+	}
+	f.objects = append(f.objects, obj)
+	return obj.handle, nil
+}
+
+func (f *fakePKCS11) FindObjectsInit(sh pkcs11.SessionHandle, temp []*pkcs11.Attribute) error {
+	var wantClass, wantLabel []byte
+	for _, a := range temp {
+		switch a.Type {
+		case pkcs11.CKA_CLASS:
+			wantClass = a.Value
+		case pkcs11.CKA_LABEL:
+			wantLabel = a.Value
+		}
+	}
+
+	f.findResults = nil
+	for _, obj := range f.objects {
+		if bytes.Equal(obj.attrs[pkcs11.CKA_CLASS], wantClass) && bytes.Equal(obj.attrs[pkcs11.CKA_LABEL], wantLabel) {
+			f.findResults = append(f.findResults, obj.handle)
+		}
+	}
+	return nil
+}
+
+func (f *fakePKCS11) FindObjects(sh pkcs11.SessionHandle, max int) ([]pkcs11.ObjectHandle, bool, error) {
+	if len(f.findResults) > max {
+		return f.findResults[:max], true, nil
+	}
+	return f.findResults, false, nil
+}
+
+func (f *fakePKCS11) FindObjectsFinal(sh pkcs11.SessionHandle) error { return nil }
+
+func (f *fakePKCS11) GetAttributeValue(sh pkcs11.SessionHandle, o pkcs11.ObjectHandle, a []*pkcs11.Attribute) ([]*pkcs11.Attribute, error) {
+	for _, obj := range f.objects {
+		if obj.handle != o {
+			continue
+		}
+		out := make([]*pkcs11.Attribute, len(a))
+		for i, want := range a {
+			out[i] = &pkcs11.Attribute{Type: want.Type, Value: obj.attrs[want.Type]}
+		}
+		return out, nil
+	}
+	return nil, errNoSuchObject
+}
+
+func (f *fakePKCS11) SignInit(sh pkcs11.SessionHandle, m []*pkcs11.Mechanism, o pkcs11.ObjectHandle) error {
+	f.signObject = o
+	return nil
+}
+
+func (f *fakePKCS11) Sign(sh pkcs11.SessionHandle, message []byte) ([]byte, error) {
+	f.signedDigests = append(f.signedDigests, message)
+	return f.signature, nil
+}
+
+var errInvalidPIN = errFake("invalid pin")
+var errNoSuchObject = errFake("no such object")
+
+type errFake string
+
+func (e errFake) Error() string { return string(e) }
+
+func TestPKCS11KeyStoreStoreThenLoad(t *testing.T) {
+	fake := &fakePKCS11{}
+	store, err := newPKCS11KeyStore(fake, "1234")
+	if err != nil {
+		t.Fatalf("newPKCS11KeyStore failed: %v", err)
+	}
+
+	account := types.Account{
+		PrivateKey: []byte("32-byte-scalar-for-secp256k1!!!!"),
+		PublicKey:  []byte("uncompressed-ec-point"),
+		Address:    "0xCAFE",
+		Symbol:     "ETH",
+	}
+	if err := store.Store(account); err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+
+	loaded, err := store.Load(account.Address)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if string(loaded.PublicKey) != string(account.PublicKey) {
+		t.Error("loaded public key does not match the stored account's")
+	}
+}
+
+func TestPKCS11KeyStoreSignUsesStoredPrivateObject(t *testing.T) {
+	fake := &fakePKCS11{signature: []byte("fake-signature")}
+	store, err := newPKCS11KeyStore(fake, "1234")
+	if err != nil {
+		t.Fatalf("newPKCS11KeyStore failed: %v", err)
+	}
+
+	account := types.Account{
+		PrivateKey: []byte("32-byte-scalar-for-secp256k1!!!!"),
+		PublicKey:  []byte("uncompressed-ec-point"),
+		Address:    "0xCAFE",
+	}
+	if err := store.Store(account); err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+
+	digest := []byte("digest-to-sign-0000000000000000")
+	sig, err := store.Sign(account.Address, digest)
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	if string(sig) != "fake-signature" {
+		t.Errorf("unexpected signature: %q", sig)
+	}
+	if len(fake.signedDigests) != 1 || string(fake.signedDigests[0]) != string(digest) {
+		t.Error("token was not asked to sign the expected digest")
+	}
+}
+
+func TestPKCS11KeyStoreSignRequiresExistingKey(t *testing.T) {
+	fake := &fakePKCS11{}
+	store, err := newPKCS11KeyStore(fake, "1234")
+	if err != nil {
+		t.Fatalf("newPKCS11KeyStore failed: %v", err)
+	}
+
+	if _, err := store.Sign("0xNEVER-PROVISIONED", []byte("digest")); err == nil {
+		t.Error("expected an error signing with a label that was never stored")
+	}
+}
+
+func TestPKCS11KeyStoreRejectsWrongPIN(t *testing.T) {
+	fake := &fakePKCS11{wrongPIN: "0000"}
+	store, err := newPKCS11KeyStore(fake, "0000")
+	if err != nil {
+		t.Fatalf("newPKCS11KeyStore failed: %v", err)
+	}
+
+	account := types.Account{PrivateKey: []byte("k"), PublicKey: []byte("p"), Address: "0xA"}
+	if err := store.Store(account); err == nil {
+		t.Error("expected login with the wrong PIN to fail")
+	}
+}
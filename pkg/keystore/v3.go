@@ -0,0 +1,175 @@
+// Package keystore provides pluggable backends for wallet private key
+// material, satisfying types.KeyStore. FileKeyStore persists an
+// scrypt+AES-128-CTR encrypted JSON file per account, in the same Web3
+// Secret Storage (V3) format go-ethereum's accounts/keystore package uses,
+// so existing Ethereum tooling can read files written here directly.
+// PKCS11KeyStore instead keeps the key non-exportable on a hardware token
+// (YubiKey, Nitrokey, SoftHSM, ...) and only ever returns signatures.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Scrypt parameters matching go-ethereum's keystore defaults
+// (accounts/keystore.StandardScryptN/P).
+const (
+	ScryptN     = 1 << 18 // 262144
+	ScryptR     = 8
+	ScryptP     = 1
+	ScryptDKLen = 32
+)
+
+// V3 mirrors the JSON structure produced/consumed by go-ethereum's
+// accounts/keystore package (Web3 Secret Storage, version 3).
+type V3 struct {
+	Address string `json:"address"`
+	Crypto  Crypto `json:"crypto"`
+	ID      string `json:"id"`
+	Version int    `json:"version"`
+}
+
+type Crypto struct {
+	Cipher       string       `json:"cipher"`
+	CipherText   string       `json:"ciphertext"`
+	CipherParams CipherParams `json:"cipherparams"`
+	KDF          string       `json:"kdf"`
+	KDFParams    KDFParams    `json:"kdfparams"`
+	MAC          string       `json:"mac"`
+}
+
+type CipherParams struct {
+	IV string `json:"iv"`
+}
+
+type KDFParams struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// EncryptV3 encrypts privateKey into the standard go-ethereum-compatible V3
+// structure under passphrase.
+func EncryptV3(privateKey []byte, address, passphrase string) (V3, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return V3{}, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, ScryptN, ScryptR, ScryptP, ScryptDKLen)
+	if err != nil {
+		return V3{}, fmt.Errorf("scrypt failed: %w", err)
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return V3{}, fmt.Errorf("failed to generate iv: %w", err)
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return V3{}, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	cipherText := make([]byte, len(privateKey))
+	cipher.NewCTR(block, iv).XORKeyStream(cipherText, privateKey)
+
+	mac := ethcrypto.Keccak256(append(append([]byte{}, derivedKey[16:32]...), cipherText...))
+
+	id, err := newUUIDv4()
+	if err != nil {
+		return V3{}, err
+	}
+
+	return V3{
+		Address: strings.ToLower(strings.TrimPrefix(address, "0x")),
+		Crypto: Crypto{
+			Cipher:     "aes-128-ctr",
+			CipherText: hex.EncodeToString(cipherText),
+			CipherParams: CipherParams{
+				IV: hex.EncodeToString(iv),
+			},
+			KDF: "scrypt",
+			KDFParams: KDFParams{
+				N:     ScryptN,
+				R:     ScryptR,
+				P:     ScryptP,
+				DKLen: ScryptDKLen,
+				Salt:  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(mac),
+		},
+		ID:      id,
+		Version: 3,
+	}, nil
+}
+
+// DecryptV3 recovers the private key sealed in ks under passphrase.
+func DecryptV3(ks V3, passphrase string) ([]byte, error) {
+	if ks.Crypto.Cipher != "aes-128-ctr" {
+		return nil, fmt.Errorf("unsupported cipher %q", ks.Crypto.Cipher)
+	}
+	if ks.Crypto.KDF != "scrypt" {
+		return nil, fmt.Errorf("unsupported kdf %q", ks.Crypto.KDF)
+	}
+
+	salt, err := hex.DecodeString(ks.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid salt: %w", err)
+	}
+	iv, err := hex.DecodeString(ks.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("invalid iv: %w", err)
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ciphertext: %w", err)
+	}
+	wantMAC, err := hex.DecodeString(ks.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mac: %w", err)
+	}
+
+	p := ks.Crypto.KDFParams
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, p.N, p.R, p.P, p.DKLen)
+	if err != nil {
+		return nil, fmt.Errorf("scrypt failed: %w", err)
+	}
+
+	mac := ethcrypto.Keccak256(append(append([]byte{}, derivedKey[16:32]...), cipherText...))
+	if hex.EncodeToString(mac) != hex.EncodeToString(wantMAC) {
+		return nil, fmt.Errorf("invalid passphrase or corrupted keystore (mac mismatch)")
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	privateKey := make([]byte, len(cipherText))
+	cipher.NewCTR(block, iv).XORKeyStream(privateKey, cipherText)
+	return privateKey, nil
+}
+
+// newUUIDv4 generates a random RFC 4122 version 4 UUID string.
+func newUUIDv4() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate uuid: %w", err)
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
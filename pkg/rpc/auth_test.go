@@ -0,0 +1,28 @@
+package rpc
+
+import "testing"
+
+func TestStaticTokenAuthenticator(t *testing.T) {
+	auth, err := NewStaticTokenAuthenticator("super-secret-token")
+	if err != nil {
+		t.Fatalf("NewStaticTokenAuthenticator failed: %v", err)
+	}
+
+	if err := auth.Authenticate("super-secret-token"); err != nil {
+		t.Errorf("expected the correct token to authenticate: %v", err)
+	}
+
+	if err := auth.Authenticate("wrong-token"); err == nil {
+		t.Error("expected an incorrect token to fail authentication")
+	}
+
+	if err := auth.Authenticate(""); err == nil {
+		t.Error("expected an empty token to fail authentication")
+	}
+}
+
+func TestNewStaticTokenAuthenticatorRejectsEmptyToken(t *testing.T) {
+	if _, err := NewStaticTokenAuthenticator(""); err == nil {
+		t.Error("expected an empty configured token to be rejected")
+	}
+}
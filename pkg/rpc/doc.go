@@ -0,0 +1,18 @@
+// Package rpc implements anvil's wallet-unlocker service: generating,
+// persisting (scrypt + AES-GCM encrypted), and unlocking a wallet file,
+// then deriving accounts from the unlocked seed on demand - turning anvil
+// from a one-shot CLI into a long-running signing daemon, modeled on lnd's
+// WalletUnlocker.
+//
+// walletunlocker.proto declares the intended wire shape for a future gRPC
+// service, but it is not served in this tree: this repo's build environment
+// has no protoc/protoc-gen-go-grpc toolchain (and no network access to fetch
+// one), so there are no generated *.pb.go/*_grpc.pb.go bindings to serve,
+// and this package does not pretend otherwise. What ships today is Gateway,
+// a plain HTTP/JSON server exposing the same five RPCs (GenSeed,
+// InitWallet, UnlockWallet, ChangePassword, DeriveAccount) as ordinary
+// POST endpoints under /v1/. Generating real bindings from
+// walletunlocker.proto against a proper toolchain should be able to replace
+// types.go and gateway.go with the generated code without touching
+// service.go, session.go, walletfile.go, or auth.go.
+package rpc
@@ -0,0 +1,58 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGatewayGenSeed(t *testing.T) {
+	svc := NewWalletUnlockerService(t.TempDir())
+	auth, _ := NewStaticTokenAuthenticator("test-token")
+	gw := NewGateway(svc, auth)
+
+	server := httptest.NewServer(gw.Handler())
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/v1/genseed", bytes.NewReader([]byte(`{}`)))
+	req.Header.Set("Authorization", "Bearer test-token")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var seedResp GenSeedResponse
+	if err := json.NewDecoder(resp.Body).Decode(&seedResp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if seedResp.Mnemonic == "" {
+		t.Error("expected a non-empty mnemonic")
+	}
+}
+
+func TestGatewayRejectsMissingToken(t *testing.T) {
+	svc := NewWalletUnlockerService(t.TempDir())
+	auth, _ := NewStaticTokenAuthenticator("test-token")
+	gw := NewGateway(svc, auth)
+
+	server := httptest.NewServer(gw.Handler())
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/v1/genseed", "application/json", bytes.NewReader([]byte(`{}`)))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", resp.StatusCode)
+	}
+}
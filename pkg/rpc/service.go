@@ -0,0 +1,158 @@
+package rpc
+
+import (
+	"fmt"
+
+	"anvil/internal/crypto"
+	"anvil/pkg/coins"
+)
+
+// WalletUnlockerService implements the business logic behind the
+// WalletUnlocker service declared in walletunlocker.proto: generating and
+// persisting an encrypted wallet file, unlocking it into an in-memory
+// session, and deriving accounts from that session's seed. It has no
+// transport dependency of its own - see Gateway for the HTTP/JSON binding.
+type WalletUnlockerService struct {
+	dataDir string
+	session *session
+}
+
+// NewWalletUnlockerService creates a service that persists its wallet file
+// under dataDir. The returned service starts locked; call InitWallet or
+// UnlockWallet before DeriveAccount.
+func NewWalletUnlockerService(dataDir string) *WalletUnlockerService {
+	return &WalletUnlockerService{
+		dataDir: dataDir,
+		session: &session{},
+	}
+}
+
+// GenSeed generates a new BIP39 mnemonic without persisting anything, so
+// callers can display it to the operator before InitWallet.
+func (s *WalletUnlockerService) GenSeed(req GenSeedRequest) (*GenSeedResponse, error) {
+	bits := req.EntropyBits
+	if bits == 0 {
+		bits = 256
+	}
+
+	mnemonic, err := crypto.GenerateMnemonic(bits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate mnemonic: %w", err)
+	}
+
+	return &GenSeedResponse{Mnemonic: mnemonic}, nil
+}
+
+// InitWallet encrypts the mnemonic under req.WalletPassphrase, persists
+// it to the data directory, and unlocks the resulting session. It refuses
+// to run if a wallet file already exists - use UnlockWallet or
+// ChangePassword for an existing one.
+func (s *WalletUnlockerService) InitWallet(req InitWalletRequest) (*InitWalletResponse, error) {
+	if req.Mnemonic == "" {
+		return nil, fmt.Errorf("mnemonic is required")
+	}
+	if req.WalletPassphrase == "" {
+		return nil, fmt.Errorf("wallet_passphrase is required")
+	}
+	if walletFileExists(s.dataDir) {
+		return nil, fmt.Errorf("a wallet file already exists in %s", s.dataDir)
+	}
+
+	data, err := encryptWalletFile(req.Mnemonic, []byte(req.WalletPassphrase))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt wallet file: %w", err)
+	}
+	if err := saveWalletFile(s.dataDir, data); err != nil {
+		return nil, err
+	}
+
+	if err := s.session.unlock(req.Mnemonic, req.SeedPassphrase); err != nil {
+		return nil, err
+	}
+
+	return &InitWalletResponse{}, nil
+}
+
+// UnlockWallet decrypts the persisted wallet file under
+// req.WalletPassphrase and holds its seed in memory for DeriveAccount.
+func (s *WalletUnlockerService) UnlockWallet(req UnlockWalletRequest) (*UnlockWalletResponse, error) {
+	data, err := loadWalletFile(s.dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonic, err := decryptWalletFile(data, []byte(req.WalletPassphrase))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.session.unlock(mnemonic, ""); err != nil {
+		return nil, err
+	}
+
+	return &UnlockWalletResponse{}, nil
+}
+
+// ChangePassword re-encrypts the persisted wallet file under
+// req.NewWalletPassphrase, verifying req.OldWalletPassphrase against the
+// file currently on disk, and unlocks the session as a side effect.
+func (s *WalletUnlockerService) ChangePassword(req ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	data, err := loadWalletFile(s.dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonic, err := decryptWalletFile(data, []byte(req.OldWalletPassphrase))
+	if err != nil {
+		return nil, err
+	}
+
+	newData, err := encryptWalletFile(mnemonic, []byte(req.NewWalletPassphrase))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt wallet file: %w", err)
+	}
+	if err := saveWalletFile(s.dataDir, newData); err != nil {
+		return nil, err
+	}
+
+	if err := s.session.unlock(mnemonic, ""); err != nil {
+		return nil, err
+	}
+
+	return &ChangePasswordResponse{}, nil
+}
+
+// DeriveAccount derives an account for req.Symbol at req.Path from the
+// unlocked session's seed. It fails if no session is unlocked yet.
+func (s *WalletUnlockerService) DeriveAccount(req DeriveAccountRequest) (*DeriveAccountResponse, error) {
+	coin, ok := coins.Lookup(req.Symbol)
+	if !ok {
+		return nil, fmt.Errorf("unsupported coin symbol %q", req.Symbol)
+	}
+
+	var resp DeriveAccountResponse
+	err := s.session.withSeed(func(seed []byte) error {
+		account, err := coin.DeriveAccount(seed, req.Path)
+		if err != nil {
+			return fmt.Errorf("failed to derive account: %w", err)
+		}
+
+		if !req.IncludePrivate {
+			account.PrivateKey = nil
+		}
+
+		resp.Account = accountJSON{
+			Path:       account.Path,
+			PrivateKey: account.PrivateKey,
+			PublicKey:  account.PublicKey,
+			Address:    account.Address,
+			Symbol:     account.Symbol,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,190 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"anvil/pkg/coins"
+	"anvil/pkg/types"
+)
+
+// fakeCoin is a minimal coins.CoinDriver used so service tests don't
+// depend on a concrete chain package or on main.go's registration.
+type fakeCoin struct {
+	symbol string
+}
+
+func (f *fakeCoin) Name() string   { return "Fake Coin" }
+func (f *fakeCoin) Symbol() string { return f.symbol }
+func (f *fakeCoin) DeriveAccount(seed []byte, path string) (types.Account, error) {
+	return types.Account{
+		Path:       path,
+		PrivateKey: []byte("fake-private-key"),
+		PublicKey:  []byte("fake-public-key"),
+		Address:    "fake-address",
+		Symbol:     f.symbol,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+func (f *fakeCoin) Curve() coins.Curve            { return coins.CurveSecp256k1 }
+func (f *fakeCoin) GetCoinType() uint32           { return 9999 }
+func (f *fakeCoin) ValidateAddress(a string) bool { return a != "" }
+
+// registerFakeCoin registers a fakeCoin under a symbol unique to the
+// calling test, so repeated test runs in the same process don't collide on
+// coins.Register's duplicate-name panic.
+func registerFakeCoin(t *testing.T) string {
+	symbol := "FAKE_" + t.Name()
+	coins.Register(symbol, &fakeCoin{symbol: symbol})
+	return symbol
+}
+
+func TestGenSeed(t *testing.T) {
+	svc := NewWalletUnlockerService(t.TempDir())
+
+	resp, err := svc.GenSeed(GenSeedRequest{})
+	if err != nil {
+		t.Fatalf("GenSeed failed: %v", err)
+	}
+	if resp.Mnemonic == "" {
+		t.Error("expected a non-empty mnemonic")
+	}
+}
+
+func TestInitWalletPersistsAndUnlocksSession(t *testing.T) {
+	symbol := registerFakeCoin(t)
+	svc := NewWalletUnlockerService(t.TempDir())
+
+	seedResp, err := svc.GenSeed(GenSeedRequest{})
+	if err != nil {
+		t.Fatalf("GenSeed failed: %v", err)
+	}
+
+	_, err = svc.InitWallet(InitWalletRequest{
+		Mnemonic:         seedResp.Mnemonic,
+		WalletPassphrase: "wallet-passphrase",
+	})
+	if err != nil {
+		t.Fatalf("InitWallet failed: %v", err)
+	}
+
+	// The session should already be unlocked - DeriveAccount should work
+	// without a separate UnlockWallet call.
+	deriveResp, err := svc.DeriveAccount(DeriveAccountRequest{Symbol: symbol, Path: "m/44'/0'/0'/0/0"})
+	if err != nil {
+		t.Fatalf("DeriveAccount failed: %v", err)
+	}
+	if deriveResp.Account.Address != "fake-address" {
+		t.Errorf("unexpected address: %s", deriveResp.Account.Address)
+	}
+}
+
+func TestInitWalletRefusesExistingFile(t *testing.T) {
+	dataDir := t.TempDir()
+	svc := NewWalletUnlockerService(dataDir)
+
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	req := InitWalletRequest{Mnemonic: mnemonic, WalletPassphrase: "passphrase"}
+
+	if _, err := svc.InitWallet(req); err != nil {
+		t.Fatalf("first InitWallet failed: %v", err)
+	}
+
+	if _, err := svc.InitWallet(req); err == nil {
+		t.Error("expected second InitWallet against the same data dir to fail")
+	}
+}
+
+func TestUnlockWalletWithPersistedFile(t *testing.T) {
+	dataDir := t.TempDir()
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	initSvc := NewWalletUnlockerService(dataDir)
+	if _, err := initSvc.InitWallet(InitWalletRequest{Mnemonic: mnemonic, WalletPassphrase: "passphrase"}); err != nil {
+		t.Fatalf("InitWallet failed: %v", err)
+	}
+
+	// A fresh service instance simulates a daemon restart: no in-memory
+	// session, only the persisted wallet file.
+	unlockSvc := NewWalletUnlockerService(dataDir)
+	if _, err := unlockSvc.UnlockWallet(UnlockWalletRequest{WalletPassphrase: "passphrase"}); err != nil {
+		t.Fatalf("UnlockWallet failed: %v", err)
+	}
+
+	if _, err := unlockSvc.UnlockWallet(UnlockWalletRequest{WalletPassphrase: "wrong-passphrase"}); err == nil {
+		t.Error("expected UnlockWallet with the wrong passphrase to fail")
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	dataDir := t.TempDir()
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	svc := NewWalletUnlockerService(dataDir)
+	if _, err := svc.InitWallet(InitWalletRequest{Mnemonic: mnemonic, WalletPassphrase: "old-passphrase"}); err != nil {
+		t.Fatalf("InitWallet failed: %v", err)
+	}
+
+	if _, err := svc.ChangePassword(ChangePasswordRequest{
+		OldWalletPassphrase: "old-passphrase",
+		NewWalletPassphrase: "new-passphrase",
+	}); err != nil {
+		t.Fatalf("ChangePassword failed: %v", err)
+	}
+
+	reopened := NewWalletUnlockerService(dataDir)
+	if _, err := reopened.UnlockWallet(UnlockWalletRequest{WalletPassphrase: "old-passphrase"}); err == nil {
+		t.Error("old passphrase should no longer unlock the wallet")
+	}
+	if _, err := reopened.UnlockWallet(UnlockWalletRequest{WalletPassphrase: "new-passphrase"}); err != nil {
+		t.Errorf("new passphrase should unlock the wallet: %v", err)
+	}
+}
+
+func TestDeriveAccountRequiresUnlock(t *testing.T) {
+	symbol := registerFakeCoin(t)
+	svc := NewWalletUnlockerService(t.TempDir())
+
+	if _, err := svc.DeriveAccount(DeriveAccountRequest{Symbol: symbol, Path: "m/44'/0'/0'/0/0"}); err == nil {
+		t.Error("expected DeriveAccount to fail before the wallet is unlocked")
+	}
+}
+
+func TestDeriveAccountStripsPrivateKeyByDefault(t *testing.T) {
+	symbol := registerFakeCoin(t)
+	svc := NewWalletUnlockerService(t.TempDir())
+
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	if _, err := svc.InitWallet(InitWalletRequest{Mnemonic: mnemonic, WalletPassphrase: "passphrase"}); err != nil {
+		t.Fatalf("InitWallet failed: %v", err)
+	}
+
+	resp, err := svc.DeriveAccount(DeriveAccountRequest{Symbol: symbol, Path: "m/44'/0'/0'/0/0"})
+	if err != nil {
+		t.Fatalf("DeriveAccount failed: %v", err)
+	}
+	if resp.Account.PrivateKey != nil {
+		t.Error("expected private key to be stripped by default")
+	}
+
+	respWithPrivate, err := svc.DeriveAccount(DeriveAccountRequest{Symbol: symbol, Path: "m/44'/0'/0'/0/0", IncludePrivate: true})
+	if err != nil {
+		t.Fatalf("DeriveAccount failed: %v", err)
+	}
+	if respWithPrivate.Account.PrivateKey == nil {
+		t.Error("expected private key to be included when IncludePrivate is set")
+	}
+}
+
+func TestDeriveAccountUnsupportedSymbol(t *testing.T) {
+	svc := NewWalletUnlockerService(t.TempDir())
+
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	if _, err := svc.InitWallet(InitWalletRequest{Mnemonic: mnemonic, WalletPassphrase: "passphrase"}); err != nil {
+		t.Fatalf("InitWallet failed: %v", err)
+	}
+
+	if _, err := svc.DeriveAccount(DeriveAccountRequest{Symbol: "NOPE", Path: "m/44'/0'/0'/0/0"}); err == nil {
+		t.Error("expected an unsupported symbol to fail")
+	}
+}
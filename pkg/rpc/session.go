@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"fmt"
+	"sync"
+
+	"anvil/internal/crypto"
+)
+
+// session holds a decrypted wallet's seed in memory after UnlockWallet (or
+// InitWallet), guarded by a mutex since DeriveAccount may be called
+// concurrently by multiple RPC clients. The seed never touches disk in
+// plaintext; it lives only here, for the lifetime of the process or until
+// lock is called.
+type session struct {
+	mu       sync.Mutex
+	unlocked bool
+	mnemonic string
+	seed     []byte
+}
+
+// unlock derives a BIP39 seed from mnemonic and holds both in memory,
+// replacing (and zeroing) any previously unlocked seed.
+func (s *session) unlock(mnemonic, seedPassphrase string) error {
+	seed, err := crypto.MnemonicToSeed(mnemonic, seedPassphrase)
+	if err != nil {
+		return fmt.Errorf("failed to derive seed from mnemonic: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.seed != nil {
+		crypto.SecureZeroMemory(s.seed)
+	}
+	s.mnemonic = mnemonic
+	s.seed = seed
+	s.unlocked = true
+	return nil
+}
+
+// lock zeroes the in-memory seed and marks the session locked again.
+func (s *session) lock() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.seed != nil {
+		crypto.SecureZeroMemory(s.seed)
+	}
+	s.seed = nil
+	s.mnemonic = ""
+	s.unlocked = false
+}
+
+// withSeed runs fn with the unlocked seed, holding the session's mutex for
+// fn's duration. It reports an error instead of running fn if the session
+// is locked.
+func (s *session) withSeed(fn func(seed []byte) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.unlocked {
+		return fmt.Errorf("wallet is locked: call UnlockWallet or InitWallet first")
+	}
+	return fn(s.seed)
+}
+
+// mnemonicForChangePassword returns the currently unlocked mnemonic, or an
+// error if the session is locked. ChangePassword does not use it: it
+// decrypts the wallet file on disk with the old passphrase instead.
+func (s *session) mnemonicForChangePassword() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.unlocked {
+		return "", fmt.Errorf("wallet is locked: call UnlockWallet first")
+	}
+	return s.mnemonic, nil
+}
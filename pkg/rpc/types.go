@@ -0,0 +1,79 @@
+package rpc
+
+// The request/response types below mirror the messages declared in
+// walletunlocker.proto field-for-field. They're hand-written rather than
+// protoc-generated because this repo's build environment has no
+// protoc/protoc-gen-go-grpc toolchain available (see the .proto file's
+// doc comment); Gateway serves them as plain JSON instead of the real
+// protobuf wire format.
+
+// GenSeedRequest requests a freshly generated mnemonic.
+type GenSeedRequest struct {
+	// EntropyBits selects the mnemonic's word count (128-256, a multiple
+	// of 32); zero defaults to 256 (24 words).
+	EntropyBits int `json:"entropy_bits"`
+}
+
+// GenSeedResponse carries the generated mnemonic.
+type GenSeedResponse struct {
+	Mnemonic string `json:"mnemonic"`
+}
+
+// InitWalletRequest persists a new encrypted wallet file from a mnemonic
+// (typically one returned by GenSeed) and unlocks it.
+type InitWalletRequest struct {
+	Mnemonic string `json:"mnemonic"`
+	// SeedPassphrase is the optional BIP39 passphrase folded into the seed
+	// itself (the "25th word"), distinct from WalletPassphrase below.
+	SeedPassphrase string `json:"seed_passphrase"`
+	// WalletPassphrase encrypts the persisted wallet file.
+	WalletPassphrase string `json:"wallet_passphrase"`
+}
+
+// InitWalletResponse is empty; success is the absence of an error.
+type InitWalletResponse struct{}
+
+// UnlockWalletRequest decrypts the persisted wallet file and unlocks it.
+type UnlockWalletRequest struct {
+	WalletPassphrase string `json:"wallet_passphrase"`
+}
+
+// UnlockWalletResponse is empty; success is the absence of an error.
+type UnlockWalletResponse struct{}
+
+// ChangePasswordRequest re-encrypts the persisted wallet file under a new
+// passphrase.
+type ChangePasswordRequest struct {
+	OldWalletPassphrase string `json:"old_wallet_passphrase"`
+	NewWalletPassphrase string `json:"new_wallet_passphrase"`
+}
+
+// ChangePasswordResponse is empty; success is the absence of an error.
+type ChangePasswordResponse struct{}
+
+// DeriveAccountRequest derives one account from the unlocked session's
+// seed.
+type DeriveAccountRequest struct {
+	// Symbol selects the coin (e.g. "BTC", "ETH") via pkg/coins.Lookup.
+	Symbol string `json:"symbol"`
+	Path   string `json:"path"`
+	// IncludePrivate mirrors types.OutputOptions.IncludePrivate: when
+	// false (the default), the response's private key is stripped.
+	IncludePrivate bool `json:"include_private"`
+}
+
+// DeriveAccountResponse carries the derived account.
+type DeriveAccountResponse struct {
+	Account accountJSON `json:"account"`
+}
+
+// accountJSON is the wire shape of the proto Account message. It's kept
+// separate from types.Account (rather than reusing it directly) since the
+// wire message has no CreatedAt field.
+type accountJSON struct {
+	Path       string `json:"path"`
+	PrivateKey []byte `json:"private_key,omitempty"`
+	PublicKey  []byte `json:"public_key"`
+	Address    string `json:"address"`
+	Symbol     string `json:"symbol"`
+}
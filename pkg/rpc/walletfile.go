@@ -0,0 +1,199 @@
+package rpc
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// Scrypt parameters for the wallet file KDF. These match the existing
+// internal/output keystore's parameters rather than aezeed's lighter ones,
+// since the wallet file (like the V3 keystore) is a long-lived file on
+// disk rather than a cipher seed meant to be retyped by hand.
+const (
+	walletFileVersion  = 1
+	walletFileSaltSize = 16
+	walletScryptN      = 1 << 18
+	walletScryptR      = 8
+	walletScryptP      = 1
+	walletScryptDKLen  = 32
+)
+
+// walletFile is the on-disk JSON structure persisted by InitWallet and
+// read back by UnlockWallet/ChangePassword. Crypto holds everything needed
+// to derive the AES-GCM key from a passphrase and decrypt the mnemonic.
+type walletFile struct {
+	Version int              `json:"version"`
+	Crypto  walletFileCrypto `json:"crypto"`
+}
+
+type walletFileCrypto struct {
+	Cipher     string           `json:"cipher"`
+	CipherText string           `json:"ciphertext"` // hex
+	Nonce      string           `json:"nonce"`      // hex
+	KDF        string           `json:"kdf"`
+	KDFParams  walletFileParams `json:"kdf_params"`
+}
+
+type walletFileParams struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"` // hex
+}
+
+// walletFilePayload is the plaintext sealed inside the wallet file: the
+// mnemonic itself, persisted (rather than just the derived seed) so
+// ChangePassword and account recovery tooling can still display it.
+type walletFilePayload struct {
+	Mnemonic string `json:"mnemonic"`
+}
+
+// encryptWalletFile derives an AES-256 key from passphrase via scrypt and
+// seals mnemonic under it, returning the JSON-encoded wallet file.
+func encryptWalletFile(mnemonic string, passphrase []byte) ([]byte, error) {
+	salt := make([]byte, walletFileSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key, err := scrypt.Key(passphrase, salt, walletScryptN, walletScryptR, walletScryptP, walletScryptDKLen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	plaintext, err := json.Marshal(walletFilePayload{Mnemonic: mnemonic})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	ciphertext := gcm.Seal(nil, nonce, plaintext, nil)
+
+	wf := walletFile{
+		Version: walletFileVersion,
+		Crypto: walletFileCrypto{
+			Cipher:     "aes-256-gcm",
+			CipherText: hex.EncodeToString(ciphertext),
+			Nonce:      hex.EncodeToString(nonce),
+			KDF:        "scrypt",
+			KDFParams: walletFileParams{
+				N:     walletScryptN,
+				R:     walletScryptR,
+				P:     walletScryptP,
+				DKLen: walletScryptDKLen,
+				Salt:  hex.EncodeToString(salt),
+			},
+		},
+	}
+
+	return json.MarshalIndent(wf, "", "  ")
+}
+
+// decryptWalletFile reverses encryptWalletFile, recovering the mnemonic
+// sealed in data under passphrase. Wrong passphrases and corrupted files
+// both surface as a GCM authentication failure.
+func decryptWalletFile(data, passphrase []byte) (string, error) {
+	var wf walletFile
+	if err := json.Unmarshal(data, &wf); err != nil {
+		return "", fmt.Errorf("failed to parse wallet file: %w", err)
+	}
+	if wf.Version != walletFileVersion {
+		return "", fmt.Errorf("unsupported wallet file version %d", wf.Version)
+	}
+
+	salt, err := hex.DecodeString(wf.Crypto.KDFParams.Salt)
+	if err != nil {
+		return "", fmt.Errorf("invalid salt: %w", err)
+	}
+	nonce, err := hex.DecodeString(wf.Crypto.Nonce)
+	if err != nil {
+		return "", fmt.Errorf("invalid nonce: %w", err)
+	}
+	ciphertext, err := hex.DecodeString(wf.Crypto.CipherText)
+	if err != nil {
+		return "", fmt.Errorf("invalid ciphertext: %w", err)
+	}
+
+	p := wf.Crypto.KDFParams
+	key, err := scrypt.Key(passphrase, salt, p.N, p.R, p.P, p.DKLen)
+	if err != nil {
+		return "", fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt wallet file: wrong passphrase or corrupted file")
+	}
+
+	var payload walletFilePayload
+	if err := json.Unmarshal(plaintext, &payload); err != nil {
+		return "", fmt.Errorf("failed to parse decrypted payload: %w", err)
+	}
+
+	return payload.Mnemonic, nil
+}
+
+// walletFilePath returns the path InitWallet/UnlockWallet persist the
+// encrypted wallet file to within dataDir.
+func walletFilePath(dataDir string) string {
+	return filepath.Join(dataDir, "wallet.json")
+}
+
+// saveWalletFile writes data to the wallet file path within dataDir,
+// creating dataDir if necessary.
+func saveWalletFile(dataDir string, data []byte) error {
+	if err := os.MkdirAll(dataDir, 0700); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+	if err := os.WriteFile(walletFilePath(dataDir), data, 0600); err != nil {
+		return fmt.Errorf("failed to write wallet file: %w", err)
+	}
+	return nil
+}
+
+// loadWalletFile reads the wallet file from within dataDir.
+func loadWalletFile(dataDir string) ([]byte, error) {
+	data, err := os.ReadFile(walletFilePath(dataDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read wallet file: %w", err)
+	}
+	return data, nil
+}
+
+// walletFileExists reports whether a wallet file has already been
+// persisted to dataDir, so InitWallet can refuse to overwrite one.
+func walletFileExists(dataDir string) bool {
+	_, err := os.Stat(walletFilePath(dataDir))
+	return err == nil
+}
@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEncryptDecryptWalletFileRoundTrip(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	data, err := encryptWalletFile(mnemonic, []byte("correct horse battery staple"))
+	if err != nil {
+		t.Fatalf("encryptWalletFile failed: %v", err)
+	}
+
+	got, err := decryptWalletFile(data, []byte("correct horse battery staple"))
+	if err != nil {
+		t.Fatalf("decryptWalletFile failed: %v", err)
+	}
+	if got != mnemonic {
+		t.Errorf("mnemonic mismatch: got %q, want %q", got, mnemonic)
+	}
+}
+
+func TestDecryptWalletFileWrongPassphrase(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	data, err := encryptWalletFile(mnemonic, []byte("right-passphrase"))
+	if err != nil {
+		t.Fatalf("encryptWalletFile failed: %v", err)
+	}
+
+	if _, err := decryptWalletFile(data, []byte("wrong-passphrase")); err == nil {
+		t.Error("expected an error when decrypting with the wrong passphrase")
+	}
+}
+
+func TestSaveAndLoadWalletFile(t *testing.T) {
+	dataDir := t.TempDir()
+
+	data, err := encryptWalletFile("test mnemonic", []byte("passphrase"))
+	if err != nil {
+		t.Fatalf("encryptWalletFile failed: %v", err)
+	}
+
+	if walletFileExists(dataDir) {
+		t.Fatal("wallet file should not exist before saving")
+	}
+
+	if err := saveWalletFile(dataDir, data); err != nil {
+		t.Fatalf("saveWalletFile failed: %v", err)
+	}
+
+	if !walletFileExists(dataDir) {
+		t.Fatal("wallet file should exist after saving")
+	}
+
+	loaded, err := loadWalletFile(dataDir)
+	if err != nil {
+		t.Fatalf("loadWalletFile failed: %v", err)
+	}
+	if string(loaded) != string(data) {
+		t.Error("loaded wallet file does not match saved data")
+	}
+}
+
+func TestSaveWalletFileCreatesDataDir(t *testing.T) {
+	dataDir := filepath.Join(t.TempDir(), "nested", "data")
+
+	data, err := encryptWalletFile("test mnemonic", []byte("passphrase"))
+	if err != nil {
+		t.Fatalf("encryptWalletFile failed: %v", err)
+	}
+
+	if err := saveWalletFile(dataDir, data); err != nil {
+		t.Fatalf("saveWalletFile failed: %v", err)
+	}
+
+	if _, err := os.Stat(walletFilePath(dataDir)); err != nil {
+		t.Errorf("expected wallet file to exist: %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+// Package signer implements Anvil's offline transaction signing flow: given
+// an unsigned transaction blob and a mnemonic, it derives the requested
+// path's private key on the airgapped machine, signs via the coin's
+// types.TransactionSigner implementation, and hands back the signed bytes.
+// The private key never leaves this process.
+package signer
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"anvil/internal/crypto"
+	"anvil/pkg/types"
+)
+
+// InputFormat identifies how an unsigned transaction blob was encoded on
+// disk so Sign can decode it before handing raw bytes to the coin.
+type InputFormat int
+
+const (
+	FormatUnknown InputFormat = iota
+	FormatHex
+	FormatBase64
+	FormatPSBT
+)
+
+func (f InputFormat) String() string {
+	switch f {
+	case FormatHex:
+		return "hex"
+	case FormatBase64:
+		return "base64"
+	case FormatPSBT:
+		return "psbt"
+	default:
+		return "unknown"
+	}
+}
+
+// psbtMagic is the BIP174 magic prefix ("psbt" || 0xff).
+var psbtMagic = []byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+// DecodeInput auto-detects and decodes an unsigned transaction blob that may
+// be hex-encoded, base64-encoded, or a raw/base64 PSBT.
+func DecodeInput(raw string) ([]byte, InputFormat, error) {
+	trimmed := strings.TrimSpace(raw)
+
+	if strings.HasPrefix(trimmed, "cHNidP") { // base64 encoding of the PSBT magic bytes
+		decoded, err := base64.StdEncoding.DecodeString(trimmed)
+		if err != nil {
+			return nil, FormatUnknown, fmt.Errorf("failed to base64-decode PSBT: %w", err)
+		}
+		return decoded, FormatPSBT, nil
+	}
+
+	if decoded, err := hex.DecodeString(strings.TrimPrefix(trimmed, "0x")); err == nil {
+		if hasPSBTMagic(decoded) {
+			return decoded, FormatPSBT, nil
+		}
+		return decoded, FormatHex, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(trimmed)
+	if err != nil {
+		return nil, FormatUnknown, fmt.Errorf("unrecognized input encoding (not hex, base64, or PSBT): %w", err)
+	}
+	if hasPSBTMagic(decoded) {
+		return decoded, FormatPSBT, nil
+	}
+	return decoded, FormatBase64, nil
+}
+
+func hasPSBTMagic(data []byte) bool {
+	if len(data) < len(psbtMagic) {
+		return false
+	}
+	for i, b := range psbtMagic {
+		if data[i] != b {
+			return false
+		}
+	}
+	return true
+}
+
+// Sign derives the private key for path from seed using coin's normal
+// derivation, signs rawTx with it via coin's types.TransactionSigner
+// implementation, and zeroes the derived key before returning.
+func Sign(coin types.Coin, seed []byte, path string, rawTx []byte, chainParams any) ([]byte, error) {
+	signer, ok := coin.(types.TransactionSigner)
+	if !ok {
+		return nil, fmt.Errorf("%s does not support offline transaction signing", coin.Symbol())
+	}
+
+	account, err := coin.DeriveAccount(seed, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive signing key: %w", err)
+	}
+	defer crypto.SecureZeroMemory(account.PrivateKey)
+
+	signed, err := signer.SignRawTransaction(account.PrivateKey, rawTx, chainParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	return signed, nil
+}
+
+// EncodeOutput re-encodes signed transaction bytes in the same format the
+// input was read as (hex blobs stay hex, everything else is base64), so
+// round-tripping through `anvil sign` doesn't surprise the caller.
+func EncodeOutput(signed []byte, format InputFormat) string {
+	if format == FormatHex {
+		return hex.EncodeToString(signed)
+	}
+	return base64.StdEncoding.EncodeToString(signed)
+}
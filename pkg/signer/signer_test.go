@@ -0,0 +1,120 @@
+package signer
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"anvil/internal/crypto"
+	"anvil/internal/ethereum"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestDecodeInputHex(t *testing.T) {
+	data, format, err := DecodeInput("0xdeadbeef")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if format != FormatHex {
+		t.Errorf("expected FormatHex, got %s", format)
+	}
+	if hex.EncodeToString(data) != "deadbeef" {
+		t.Errorf("unexpected decoded bytes: %x", data)
+	}
+}
+
+func TestDecodeInputBase64(t *testing.T) {
+	raw := base64.StdEncoding.EncodeToString([]byte{0x01, 0x02, 0x03, 0x09, 0x0a})
+	data, format, err := DecodeInput(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if format != FormatBase64 {
+		t.Errorf("expected FormatBase64, got %s", format)
+	}
+	if len(data) != 5 {
+		t.Errorf("expected 5 decoded bytes, got %d", len(data))
+	}
+}
+
+func TestDecodeInputPSBTHex(t *testing.T) {
+	psbt := append([]byte{0x70, 0x73, 0x62, 0x74, 0xff}, 0x00, 0x01)
+	data, format, err := DecodeInput(hex.EncodeToString(psbt))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if format != FormatPSBT {
+		t.Errorf("expected FormatPSBT, got %s", format)
+	}
+	if len(data) != len(psbt) {
+		t.Errorf("unexpected decoded length: %d", len(data))
+	}
+}
+
+func TestDecodeInputPSBTBase64(t *testing.T) {
+	psbt := append([]byte{0x70, 0x73, 0x62, 0x74, 0xff}, 0x00, 0x01)
+	raw := base64.StdEncoding.EncodeToString(psbt)
+
+	_, format, err := DecodeInput(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if format != FormatPSBT {
+		t.Errorf("expected FormatPSBT, got %s", format)
+	}
+}
+
+// TestSignRoundTripEthereum exercises Sign end-to-end through
+// coin.DeriveAccount rather than a hand-built Account, so it catches bugs
+// in DeriveAccount's private key handling (e.g. a zeroed key) that a test
+// only covering DecodeInput/EncodeOutput would miss.
+func TestSignRoundTripEthereum(t *testing.T) {
+	seed, err := crypto.MnemonicToSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+	if err != nil {
+		t.Fatalf("failed to generate seed: %v", err)
+	}
+	defer crypto.SecureZeroMemory(seed)
+
+	eth := ethereum.NewEthereum()
+	path := "m/44'/60'/0'/0/0"
+	account, err := eth.DeriveAccount(seed, path)
+	if err != nil {
+		t.Fatalf("DeriveAccount failed: %v", err)
+	}
+
+	rawTx := []byte{0xde, 0xad, 0xbe, 0xef}
+	sig, err := Sign(eth, seed, path, rawTx, nil)
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	if len(sig) != 65 {
+		t.Fatalf("expected a 65-byte signature, got %d bytes", len(sig))
+	}
+
+	hash := ethcrypto.Keccak256(rawTx)
+	publicKey, err := ethcrypto.SigToPub(hash, sig)
+	if err != nil {
+		t.Fatalf("failed to recover public key: %v", err)
+	}
+	recovered := ethereum.AddressFromPublicKey(ethcrypto.FromECDSAPub(publicKey))
+	if recovered != account.Address {
+		t.Errorf("signature recovers to %s, want the derived account's address %s", recovered, account.Address)
+	}
+}
+
+func TestEncodeOutputRoundTrip(t *testing.T) {
+	signed := []byte{0xaa, 0xbb, 0xcc}
+
+	if got := EncodeOutput(signed, FormatHex); got != "aabbcc" {
+		t.Errorf("expected hex encoding, got %s", got)
+	}
+
+	b64 := EncodeOutput(signed, FormatBase64)
+	decoded, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		t.Fatalf("unexpected error decoding base64 output: %v", err)
+	}
+	if string(decoded) != string(signed) {
+		t.Errorf("base64 round-trip mismatch")
+	}
+}
@@ -0,0 +1,93 @@
+package sss
+
+// GF(256) arithmetic for Shamir's Secret Sharing, using the same
+// reduction polynomial (x^8 + x^4 + x^3 + x + 1, 0x11B) and generator (3)
+// as AES and most other GF(256) SSS implementations. Addition and
+// subtraction are both XOR in this field; only multiplication, division,
+// and exponentiation need the log/exp tables built in init.
+
+var gf256Exp [255]byte
+var gf256Log [256]byte
+
+func init() {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		gf256Exp[i] = x
+		gf256Log[x] = byte(i)
+		x = gf256MulNoTable(x, 3)
+	}
+}
+
+// gf256MulNoTable multiplies a and b via the standard shift-and-reduce
+// algorithm; used only to bootstrap the log/exp tables in init, before
+// gf256Mul's table-based fast path is available.
+func gf256MulNoTable(a, b byte) byte {
+	var result byte
+	for b > 0 {
+		if b&1 != 0 {
+			result ^= a
+		}
+		hiBitSet := a&0x80 != 0
+		a <<= 1
+		if hiBitSet {
+			a ^= 0x1B
+		}
+		b >>= 1
+	}
+	return result
+}
+
+// gf256Mul multiplies a and b in GF(256).
+func gf256Mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	sum := int(gf256Log[a]) + int(gf256Log[b])
+	return gf256Exp[sum%255]
+}
+
+// gf256Div divides a by b in GF(256). b must be non-zero.
+func gf256Div(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	diff := (int(gf256Log[a]) - int(gf256Log[b]) + 255) % 255
+	return gf256Exp[diff]
+}
+
+// evalPoly evaluates the polynomial with the given coefficients
+// (coeffs[0] the constant term) at x, via Horner's method.
+func evalPoly(coeffs []byte, x byte) byte {
+	result := byte(0)
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		result = gf256Mul(result, x) ^ coeffs[i]
+	}
+	return result
+}
+
+// interpolateAtZero evaluates, at x=0, the unique polynomial passing
+// through points (Lagrange interpolation) - recovering a secret byte
+// from that many shares' y-values at that byte position.
+func interpolateAtZero(points [][2]byte) byte {
+	var result byte
+	for i, pi := range points {
+		xi, yi := pi[0], pi[1]
+
+		num := byte(1)
+		den := byte(1)
+		for j, pj := range points {
+			if i == j {
+				continue
+			}
+			xj := pj[0]
+			// The Lagrange basis polynomial at x=0 is
+			// product(xj / (xj - xi)) over all j != i; subtraction is XOR
+			// in GF(256).
+			num = gf256Mul(num, xj)
+			den = gf256Mul(den, xj^xi)
+		}
+		term := gf256Mul(yi, gf256Div(num, den))
+		result ^= term
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package sss
+
+import "testing"
+
+func TestGF256MulMatchesShiftAndReduce(t *testing.T) {
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			got := gf256Mul(byte(a), byte(b))
+			want := gf256MulNoTable(byte(a), byte(b))
+			if got != want {
+				t.Fatalf("gf256Mul(%d, %d) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestGF256DivInvertsMul(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			product := gf256Mul(byte(a), byte(b))
+			if got := gf256Div(product, byte(b)); got != byte(a) {
+				t.Fatalf("gf256Div(gf256Mul(%d, %d), %d) = %d, want %d", a, b, b, got, a)
+			}
+		}
+	}
+}
+
+func TestEvalPolyConstantTerm(t *testing.T) {
+	coeffs := []byte{42, 7, 99}
+	if got := evalPoly(coeffs, 0); got != 42 {
+		t.Errorf("evalPoly at x=0 = %d, want the constant term 42", got)
+	}
+}
+
+func TestInterpolateAtZeroRecoversConstantTerm(t *testing.T) {
+	coeffs := []byte{200, 11, 3, 250}
+	points := [][2]byte{
+		{1, evalPoly(coeffs, 1)},
+		{2, evalPoly(coeffs, 2)},
+		{3, evalPoly(coeffs, 3)},
+		{4, evalPoly(coeffs, 4)},
+	}
+	if got := interpolateAtZero(points); got != coeffs[0] {
+		t.Errorf("interpolateAtZero = %d, want %d", got, coeffs[0])
+	}
+}
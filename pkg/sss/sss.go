@@ -0,0 +1,275 @@
+// Package sss implements Shamir's Secret Sharing over GF(256), SLIP-39
+// style, for splitting a wallet's raw BIP39 entropy (not its mnemonic
+// string) into N word-encoded shares, any K of which reconstruct it.
+// Operating on entropy rather than the mnemonic keeps shares shorter, since
+// an already-redundant phrase is not re-expanded.
+//
+// Each byte of the secret is the constant term of its own random
+// degree-(K-1) polynomial over GF(256); a share is that polynomial's value
+// at x = share index, for every byte, so an attacker holding fewer than K
+// shares learns nothing about the secret (the remaining byte values are
+// equally likely to be anything). Split generates one shared, random
+// per-group salt and keys an HMAC-SHA256 tag over each share with it, so
+// Combine can detect a corrupted or mistyped share before it silently
+// reconstructs the wrong secret. This tag is not a defense against a
+// malicious share-holder - the salt travels inside the share itself, same
+// as SLIP-39's own Reed-Solomon checksum - it only catches transcription
+// errors and bit rot.
+//
+// Shares are word-encoded for transcription the same way
+// internal/crypto's aezeed cipher seed is: 11 bits per word against the
+// bundled BIP39 wordlist, reusing that wordlist rather than vendoring a
+// second one for the same reason aezeed does.
+package sss
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"anvil/internal/crypto"
+	"anvil/internal/crypto/bech32"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// shareVersion is the wire-format version of a Share's encoded bytes.
+// Bump this if the layout below ever changes.
+const shareVersion byte = 0
+
+// saltSize is the length in bytes of the per-group salt embedded in every
+// share and used as the HMAC-SHA256 key for that share's integrity tag.
+const saltSize = 8
+
+// tagSize is the length in bytes of a share's HMAC-SHA256 integrity tag.
+const tagSize = sha256.Size
+
+// Share is one of the N shares produced by Split. A Share on its own
+// reveals nothing about the secret; Combine needs at least Threshold of
+// them, all from the same Split call, to reconstruct it.
+type Share struct {
+	// Version is the wire-format version this share was encoded with.
+	Version byte
+	// Threshold is K: the number of shares required to reconstruct the
+	// secret, identical across every share from the same Split call.
+	Threshold byte
+	// Index is this share's x-coordinate (1..N). Never 0: evaluating a
+	// share's polynomial at x=0 would hand back the secret byte directly.
+	Index byte
+	// Salt is the group's shared HMAC key, identical across every share
+	// from the same Split call.
+	Salt []byte
+	// Payload is this share's y-values, one byte per secret byte.
+	Payload []byte
+	// Tag is the HMAC-SHA256 integrity tag over Version, Threshold, Index,
+	// and Payload, keyed by Salt. Combine and ParseShare recompute it from
+	// those fields and compare, to catch a share that was corrupted or
+	// mistyped (or simply hand-edited) after Split produced it.
+	Tag []byte
+}
+
+// Split divides secret into n shares, any k of which reconstruct it via
+// Combine. secret must be raw BIP39 entropy: 16, 20, 24, 28, or 32 bytes
+// (128/160/192/224/256 bits).
+func Split(secret []byte, k, n int) ([]Share, error) {
+	if err := validateEntropyLength(len(secret)); err != nil {
+		return nil, err
+	}
+	if k < 2 {
+		return nil, fmt.Errorf("threshold must be at least 2, got %d", k)
+	}
+	if n < k {
+		return nil, fmt.Errorf("share count %d must be at least the threshold %d", n, k)
+	}
+	if n > 255 {
+		return nil, fmt.Errorf("share count must be at most 255, got %d", n)
+	}
+
+	salt, err := crypto.SecureRandom(saltSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate group salt: %w", err)
+	}
+
+	// coeffs[j] holds secret byte j's polynomial coefficients: coeffs[j][0]
+	// is the secret byte itself, coeffs[j][1:k] are random. Every share is
+	// a point on the same len(secret) polynomials, so generating the coefficients
+	// once up front (rather than per share) is both simpler and correct.
+	coeffs := make([][]byte, len(secret))
+	for j, b := range secret {
+		coeffs[j] = make([]byte, k)
+		coeffs[j][0] = b
+		random, err := crypto.SecureRandom(k - 1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate share coefficients: %w", err)
+		}
+		copy(coeffs[j][1:], random)
+	}
+
+	shares := make([]Share, n)
+	for i := 0; i < n; i++ {
+		x := byte(i + 1)
+		payload := make([]byte, len(secret))
+		for j := range secret {
+			payload[j] = evalPoly(coeffs[j], x)
+		}
+		share := Share{
+			Version:   shareVersion,
+			Threshold: byte(k),
+			Index:     x,
+			Salt:      append([]byte(nil), salt...),
+			Payload:   payload,
+		}
+		share.Tag = shareTag(share)
+		shares[i] = share
+	}
+	return shares, nil
+}
+
+// Combine reconstructs the secret from shares, which must all come from
+// the same Split call (same version, threshold, and group salt) and
+// number at least the recorded threshold. It returns an error if any
+// share's integrity tag doesn't match its payload, or if the shares
+// disagree on index (duplicate or conflicting x-coordinates).
+func Combine(shares []Share) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, fmt.Errorf("no shares provided")
+	}
+
+	first := shares[0]
+	if err := validateEntropyLength(len(first.Payload)); err != nil {
+		return nil, err
+	}
+	if len(shares) < int(first.Threshold) {
+		return nil, fmt.Errorf("need at least %d shares, got %d", first.Threshold, len(shares))
+	}
+
+	seenIndex := make(map[byte]bool, len(shares))
+	for _, s := range shares {
+		if s.Version != first.Version || s.Threshold != first.Threshold || !hmac.Equal(s.Salt, first.Salt) {
+			return nil, fmt.Errorf("share %d belongs to a different Split group than share %d", s.Index, first.Index)
+		}
+		if len(s.Payload) != len(first.Payload) {
+			return nil, fmt.Errorf("share %d has a payload length mismatched with the rest of the group", s.Index)
+		}
+		if s.Index == 0 {
+			return nil, fmt.Errorf("share has an invalid index of 0")
+		}
+		if seenIndex[s.Index] {
+			return nil, fmt.Errorf("duplicate share index %d", s.Index)
+		}
+		seenIndex[s.Index] = true
+		if !shareTagValid(s) {
+			return nil, fmt.Errorf("share %d failed its integrity check - it may be corrupted or mistyped", s.Index)
+		}
+	}
+
+	secret := make([]byte, len(first.Payload))
+	for j := range secret {
+		points := make([][2]byte, len(shares))
+		for i, s := range shares {
+			points[i] = [2]byte{s.Index, s.Payload[j]}
+		}
+		secret[j] = interpolateAtZero(points)
+	}
+	return secret, nil
+}
+
+func validateEntropyLength(n int) error {
+	switch n * 8 {
+	case 128, 160, 192, 224, 256:
+		return nil
+	default:
+		return fmt.Errorf("secret must be 16, 20, 24, 28, or 32 bytes of raw entropy, got %d", n)
+	}
+}
+
+// shareTag computes s's expected HMAC-SHA256 tag, keyed by its group salt
+// over version || threshold || index || payload.
+func shareTag(s Share) []byte {
+	mac := hmac.New(sha256.New, s.Salt)
+	mac.Write([]byte{s.Version, s.Threshold, s.Index})
+	mac.Write(s.Payload)
+	return mac.Sum(nil)
+}
+
+// shareTagValid reports whether s.Tag matches its freshly recomputed tag.
+func shareTagValid(s Share) bool {
+	return hmac.Equal(shareTag(s), s.Tag)
+}
+
+// Encode renders s as space-separated words drawn from the bundled BIP39
+// wordlist, the same 11-bits-per-word scheme internal/crypto's aezeed
+// format uses, wrapping version || threshold || index || salt || payload
+// || tag.
+func (s Share) Encode() (string, error) {
+	tag := s.Tag
+	if tag == nil {
+		tag = shareTag(s)
+	}
+
+	blob := make([]byte, 0, 3+len(s.Salt)+len(s.Payload)+tagSize)
+	blob = append(blob, s.Version, s.Threshold, s.Index)
+	blob = append(blob, s.Salt...)
+	blob = append(blob, s.Payload...)
+	blob = append(blob, tag...)
+
+	groups, err := bech32.ConvertBits(blob, 8, 11, true)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode share: %w", err)
+	}
+
+	wordList := bip39.GetWordList()
+	words := make([]string, len(groups))
+	for i, g := range groups {
+		words[i] = wordList[g]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// ParseShare reverses Encode and verifies the decoded share's integrity
+// tag, so a mistyped or corrupted share is rejected immediately rather
+// than surfacing as a confusing Combine failure later.
+func ParseShare(words string) (Share, error) {
+	fields := strings.Fields(words)
+	indices := make([]int, len(fields))
+	for i, w := range fields {
+		idx, ok := bip39.GetWordIndex(w)
+		if !ok {
+			return Share{}, fmt.Errorf("word %q is not in the BIP39 wordlist", w)
+		}
+		indices[i] = idx
+	}
+
+	groups, err := bech32.ConvertBits(indices, 11, 8, false)
+	if err != nil {
+		return Share{}, fmt.Errorf("failed to decode share words: %w", err)
+	}
+	blob := make([]byte, len(groups))
+	for i, g := range groups {
+		blob[i] = byte(g)
+	}
+
+	const headerSize = 3
+	if len(blob) < headerSize+saltSize+tagSize+1 {
+		return Share{}, fmt.Errorf("decoded share is too short")
+	}
+
+	s := Share{
+		Version:   blob[0],
+		Threshold: blob[1],
+		Index:     blob[2],
+		Salt:      append([]byte(nil), blob[headerSize:headerSize+saltSize]...),
+		Payload:   append([]byte(nil), blob[headerSize+saltSize:len(blob)-tagSize]...),
+		Tag:       append([]byte(nil), blob[len(blob)-tagSize:]...),
+	}
+	if s.Version != shareVersion {
+		return Share{}, fmt.Errorf("unsupported share version %d", s.Version)
+	}
+	if err := validateEntropyLength(len(s.Payload)); err != nil {
+		return Share{}, err
+	}
+	if !shareTagValid(s) {
+		return Share{}, fmt.Errorf("share %d failed its integrity check - it may be corrupted or mistyped", s.Index)
+	}
+	return s, nil
+}
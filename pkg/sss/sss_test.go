@@ -0,0 +1,190 @@
+package sss
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"anvil/internal/crypto"
+)
+
+func TestSplitCombineRoundTrip(t *testing.T) {
+	secret, err := crypto.SecureRandom(32)
+	if err != nil {
+		t.Fatalf("SecureRandom failed: %v", err)
+	}
+
+	shares, err := Split(secret, 3, 5)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	if len(shares) != 5 {
+		t.Fatalf("expected 5 shares, got %d", len(shares))
+	}
+
+	got, err := Combine(shares[1:4])
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Error("combined secret does not match the original")
+	}
+}
+
+func TestCombineWithAllShares(t *testing.T) {
+	secret, _ := crypto.SecureRandom(16)
+	shares, err := Split(secret, 2, 4)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	got, err := Combine(shares)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Error("combined secret does not match the original")
+	}
+}
+
+func TestCombineBelowThresholdFails(t *testing.T) {
+	secret, _ := crypto.SecureRandom(16)
+	shares, err := Split(secret, 3, 5)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	if _, err := Combine(shares[:2]); err == nil {
+		t.Error("expected Combine with fewer than the threshold to fail")
+	}
+}
+
+func TestCombineWrongSubsetsAgree(t *testing.T) {
+	secret, _ := crypto.SecureRandom(20)
+	shares, err := Split(secret, 3, 6)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	first, err := Combine([]Share{shares[0], shares[2], shares[4]})
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	second, err := Combine([]Share{shares[1], shares[3], shares[5]})
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Error("different threshold-sized subsets reconstructed different secrets")
+	}
+}
+
+func TestCombineDetectsTamperedPayload(t *testing.T) {
+	secret, _ := crypto.SecureRandom(16)
+	shares, err := Split(secret, 2, 3)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	shares[0].Payload[0] ^= 0xFF
+	if _, err := Combine(shares[:2]); err == nil {
+		t.Error("expected Combine to reject a tampered payload")
+	}
+}
+
+func TestCombineDetectsMixedGroups(t *testing.T) {
+	secret, _ := crypto.SecureRandom(16)
+	groupA, err := Split(secret, 2, 3)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	groupB, err := Split(secret, 2, 3)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	if _, err := Combine([]Share{groupA[0], groupB[1]}); err == nil {
+		t.Error("expected Combine to reject shares from two different Split calls")
+	}
+}
+
+func TestCombineDetectsDuplicateIndex(t *testing.T) {
+	secret, _ := crypto.SecureRandom(16)
+	shares, err := Split(secret, 2, 3)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	if _, err := Combine([]Share{shares[0], shares[0]}); err == nil {
+		t.Error("expected Combine to reject a duplicate share index")
+	}
+}
+
+func TestSplitRejectsInvalidEntropyLength(t *testing.T) {
+	if _, err := Split(make([]byte, 15), 2, 3); err == nil {
+		t.Error("expected Split to reject a non-BIP39 entropy length")
+	}
+}
+
+func TestSplitRejectsThresholdAboveCount(t *testing.T) {
+	secret, _ := crypto.SecureRandom(16)
+	if _, err := Split(secret, 4, 3); err == nil {
+		t.Error("expected Split to reject a threshold greater than the share count")
+	}
+}
+
+func TestEncodeParseShareRoundTrip(t *testing.T) {
+	secret, _ := crypto.SecureRandom(32)
+	shares, err := Split(secret, 3, 5)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	words, err := shares[0].Encode()
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	parsed, err := ParseShare(words)
+	if err != nil {
+		t.Fatalf("ParseShare failed: %v", err)
+	}
+	if parsed.Index != shares[0].Index || parsed.Threshold != shares[0].Threshold {
+		t.Error("parsed share metadata does not match the original")
+	}
+	if !bytes.Equal(parsed.Payload, shares[0].Payload) {
+		t.Error("parsed share payload does not match the original")
+	}
+
+	reconstructed, err := Combine([]Share{parsed, shares[1], shares[2]})
+	if err != nil {
+		t.Fatalf("Combine with a parsed share failed: %v", err)
+	}
+	if !bytes.Equal(reconstructed, secret) {
+		t.Error("reconstructed secret does not match the original")
+	}
+}
+
+func TestParseShareRejectsMistypedWord(t *testing.T) {
+	secret, _ := crypto.SecureRandom(16)
+	shares, err := Split(secret, 2, 3)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	words, err := shares[0].Encode()
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	fields := strings.Fields(words)
+	if fields[0] == "abandon" {
+		fields[0] = "ability"
+	} else {
+		fields[0] = "abandon"
+	}
+
+	if _, err := ParseShare(strings.Join(fields, " ")); err == nil {
+		t.Error("expected ParseShare to reject a mistyped word")
+	}
+}
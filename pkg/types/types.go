@@ -1,8 +1,11 @@
 package types
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -13,6 +16,64 @@ type Coin interface {
 	DeriveAccount(seed []byte, path string) (Account, error)
 }
 
+// TransactionSigner is implemented by coins that can sign an already-built,
+// unsigned raw transaction with a derived private key - as opposed to Coin's
+// DeriveAccount, which only produces keys/addresses. chainParams carries any
+// coin-specific signing context (e.g. *chaincfg.Params for Bitcoin); coins
+// that don't need one can ignore it.
+type TransactionSigner interface {
+	SignRawTransaction(privKey []byte, rawTx []byte, chainParams any) ([]byte, error)
+}
+
+// HardwareCoin is implemented by coins that can derive addresses and sign
+// transactions on a connected hardware device instead of an in-memory seed.
+// Unlike Coin, it never has access to private key material - derivation and
+// signing both happen on-device.
+type HardwareCoin interface {
+	DeriveAddress(path string) (string, []byte, error)
+	SignTx(path string, tx []byte) ([]byte, error)
+}
+
+// KeyStore is implemented by backends that hold private key material outside
+// of an in-memory Account - a local encrypted file, or a hardware token that
+// never exports the key at all. path identifies the stored key within the
+// backend (a file path for a file-backed store, a token label for a hardware
+// one); it is opaque to callers beyond that. Store provisions a new key,
+// Load reads back an account's public material, and Sign produces a
+// signature over a caller-supplied digest without ever handing back the
+// private key itself.
+type KeyStore interface {
+	Store(account Account) error
+	Load(path string) (Account, error)
+	Sign(path string, digest []byte) ([]byte, error)
+}
+
+// Signer is implemented by coins that support personal-message signing,
+// verification, and signer-address recovery (EIP-191 for Ethereum-family
+// coins, TIP-191 for TRON) - as opposed to TransactionSigner, which signs a
+// raw on-chain transaction rather than an arbitrary message.
+type Signer interface {
+	Sign(privateKey, message []byte) ([]byte, error)
+	Verify(address string, message, sig []byte) (bool, error)
+	RecoverAddress(message, sig []byte) (string, error)
+}
+
+// VanityGenerator is implemented by coins that can search for a keypair
+// whose address matches a caller-supplied prefix, by generating random keys
+// rather than deriving from a seed. ctx cancels the search (e.g. on a
+// timeout); workers controls how many goroutines share the search.
+type VanityGenerator interface {
+	GenerateVanityAddress(ctx context.Context, prefix string, workers int) (*Account, error)
+}
+
+// HexConverter is implemented by coins whose native address format can be
+// round-tripped to a plain hex representation (e.g. TRON's Base58Check
+// addresses vs. the hex format used by its underlying EVM-style tooling).
+type HexConverter interface {
+	AddressToHex(address string) (string, error)
+	HexToAddress(hexAddress string) (string, error)
+}
+
 // Account represents a cryptocurrency account with keys and address
 type Account struct {
 	Path       string    `json:"path"`
@@ -31,20 +92,29 @@ type Wallet struct {
 	CoinTypes map[string][]uint32 `json:"coin_types"` // Maps symbol to BIP44 coin types
 	CreatedAt time.Time           `json:"created_at"`
 	Version   string              `json:"version"`
+
+	// RecoveryBirthday, when set, is the earliest date this wallet's
+	// accounts could have any on-chain activity. Recovery tooling can use
+	// it to skip chain history older than the seed itself instead of
+	// scanning from genesis. Populated from an aezeed cipher seed's
+	// encoded birthday; zero-value (omitted) for plain BIP39 mnemonics.
+	RecoveryBirthday *time.Time `json:"recovery_birthday,omitempty"`
 }
 
 // MarshalSafeJSON returns JSON without sensitive fields
 func (w *Wallet) MarshalSafeJSON() ([]byte, error) {
 	safe := struct {
-		Accounts  []SafeAccount       `json:"accounts"`
-		CoinTypes map[string][]uint32 `json:"coin_types"`
-		CreatedAt time.Time           `json:"created_at"`
-		Version   string              `json:"version"`
+		Accounts         []SafeAccount       `json:"accounts"`
+		CoinTypes        map[string][]uint32 `json:"coin_types"`
+		CreatedAt        time.Time           `json:"created_at"`
+		Version          string              `json:"version"`
+		RecoveryBirthday *time.Time          `json:"recovery_birthday,omitempty"`
 	}{
-		Accounts:  make([]SafeAccount, len(w.Accounts)),
-		CoinTypes: w.CoinTypes,
-		CreatedAt: w.CreatedAt,
-		Version:   w.Version,
+		Accounts:         make([]SafeAccount, len(w.Accounts)),
+		CoinTypes:        w.CoinTypes,
+		CreatedAt:        w.CreatedAt,
+		Version:          w.Version,
+		RecoveryBirthday: w.RecoveryBirthday,
 	}
 
 	for i, acc := range w.Accounts {
@@ -69,18 +139,35 @@ type SafeAccount struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
-// DerivationPath represents a BIP32/BIP44 derivation path
+// DerivationComponent is one level of a parsed BIP32 path: its numeric index
+// and whether it's hardened (suffixed with ' in path notation).
+type DerivationComponent struct {
+	Value    uint32 `json:"value"`
+	Hardened bool   `json:"hardened"`
+}
+
+// DerivationPath is a parsed BIP32 derivation path, e.g. "m/44'/0'/0'/0/0"
+// (BIP44) or "m/86'/0'/0'/0/0" (BIP86 Taproot). Unlike a fixed
+// purpose/coin/account/change/index layout, it holds an arbitrary number of
+// components, so paths of any depth or hardening pattern - BIP49/84/86
+// purposes, account-level xpub paths, nonstandard hardware-wallet app paths
+// - all parse and re-encode the same way.
 type DerivationPath struct {
-	Purpose  uint32 `json:"purpose"`   // Usually 44, 49, or 84
-	CoinType uint32 `json:"coin_type"` // BIP44 registered coin type
-	Account  uint32 `json:"account"`   // Account index
-	Change   uint32 `json:"change"`    // 0 for external, 1 for internal
-	Index    uint32 `json:"index"`     // Address index
+	Components []DerivationComponent `json:"components"`
 }
 
-// String returns the string representation of the derivation path
+// String returns the path in "m/c0/c1/.../cn" notation, appending ' to each
+// hardened component.
 func (dp DerivationPath) String() string {
-	return fmt.Sprintf("m/%d'/%d'/%d'/%d/%d", dp.Purpose, dp.CoinType, dp.Account, dp.Change, dp.Index)
+	parts := make([]string, len(dp.Components))
+	for i, c := range dp.Components {
+		if c.Hardened {
+			parts[i] = fmt.Sprintf("%d'", c.Value)
+		} else {
+			parts[i] = fmt.Sprintf("%d", c.Value)
+		}
+	}
+	return "m/" + strings.Join(parts, "/")
 }
 
 // ParseDerivationPath parses a string like "m/44'/0'/0'/0/0" into DerivationPath
@@ -89,6 +176,152 @@ func ParseDerivationPath(path string) (DerivationPath, error) {
 	return DerivationPath{}, nil
 }
 
+// Bip44 is a structured BIP44 derivation path fixed to purpose 44'. It
+// replaces ad-hoc fmt.Sprintf path strings so callers can't typo a
+// derivation path or mix up which components a coin hardens.
+//
+// HardenedComponents counts how many of the path's components - purpose,
+// coin, account, change, address index, in that order - are hardened.
+// Purpose is always hardened, so HardenedComponents is never less than 1.
+// Most coins (Bitcoin, Ethereum, TRON) harden only purpose/coin/account
+// (HardenedComponents = 3) and derive change/address index normally.
+// Solana's standard path also hardens change and omits the address index
+// level entirely (HardenedComponents = 4); String/ToPath reflect that by
+// dropping the final component.
+type Bip44 struct {
+	Coin               uint32
+	Account            uint32
+	Change             uint32
+	AddressIndex       uint32
+	HardenedComponents uint8
+}
+
+// hardenedComponent formats a path component, appending ' when idx (the
+// component's 1-indexed position counting purpose as 1) is covered by h
+// hardened components.
+func hardenedComponent(value uint32, idx, h uint8) string {
+	if idx <= h {
+		return fmt.Sprintf("%d'", value)
+	}
+	return fmt.Sprintf("%d", value)
+}
+
+// String returns the path in the usual "m/44'/coin'/account'/change/index"
+// notation, honoring HardenedComponents. See the Bip44 doc comment for how
+// HardenedComponents == 4 (Solana's convention) omits the address index.
+func (p Bip44) String() string {
+	path := fmt.Sprintf("m/44'/%s/%s/%s",
+		hardenedComponent(p.Coin, 2, p.HardenedComponents),
+		hardenedComponent(p.Account, 3, p.HardenedComponents),
+		hardenedComponent(p.Change, 4, p.HardenedComponents),
+	)
+	if p.HardenedComponents != 4 {
+		path += "/" + hardenedComponent(p.AddressIndex, 5, p.HardenedComponents)
+	}
+	return path
+}
+
+// ToPath is an alias for String, kept so call sites that build a path for a
+// Coin.DeriveAccount string argument read naturally as "p.ToPath()".
+func (p Bip44) ToPath() string {
+	return p.String()
+}
+
+// ParseBip44 parses a "m/44'/..." path produced by String/ToPath back into a
+// Bip44. It only accepts purpose 44' and requires hardening to be a
+// contiguous prefix of the components (no coin unhardened while account is
+// hardened, etc.), since that's the only shape ToPath ever produces.
+func ParseBip44(s string) (Bip44, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 5 && len(parts) != 6 {
+		return Bip44{}, fmt.Errorf("invalid BIP44 path %q", s)
+	}
+	if parts[0] != "m" || parts[1] != "44'" {
+		return Bip44{}, fmt.Errorf("invalid BIP44 path %q: must start with m/44'", s)
+	}
+
+	values := make([]uint32, len(parts)-2)
+	hardened := make([]bool, len(parts)-2)
+	for i, part := range parts[2:] {
+		v, isHardened, err := parseBip44Component(part)
+		if err != nil {
+			return Bip44{}, fmt.Errorf("invalid BIP44 path %q: %w", s, err)
+		}
+		values[i] = v
+		hardened[i] = isHardened
+	}
+
+	h := uint8(1) // purpose is always hardened
+	for _, isHardened := range hardened {
+		if !isHardened {
+			break
+		}
+		h++
+	}
+	for i, isHardened := range hardened {
+		if isHardened != (uint8(i)+2 <= h) {
+			return Bip44{}, fmt.Errorf("invalid BIP44 path %q: hardening is not a contiguous prefix", s)
+		}
+	}
+	if len(values) == 3 && h != 4 {
+		return Bip44{}, fmt.Errorf("invalid BIP44 path %q: a 4-component path requires change to be hardened", s)
+	}
+
+	p := Bip44{
+		Coin:               values[0],
+		Account:            values[1],
+		Change:             values[2],
+		HardenedComponents: h,
+	}
+	if len(values) == 4 {
+		p.AddressIndex = values[3]
+	}
+	return p, nil
+}
+
+func parseBip44Component(s string) (uint32, bool, error) {
+	hardened := strings.HasSuffix(s, "'")
+	s = strings.TrimSuffix(s, "'")
+	v, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid path component %q: %w", s, err)
+	}
+	return uint32(v), hardened, nil
+}
+
+// Bip44Range batch-derives a contiguous range of accounts or address
+// indexes from a base Bip44 path, for gap-limit style address discovery
+// (e.g. `anvil scan`) instead of the old hardcoded 3-path limit.
+type Bip44Range struct {
+	StartIndex uint32
+	Count      uint32
+}
+
+// Expand returns Count Bip44 paths built from base. For coins that harden
+// the change level and drop the address index (HardenedComponents == 4,
+// e.g. Solana), the account component is varied since that's the only
+// index-like field such paths expose; otherwise the address index is
+// varied, matching normal BIP44 gap-limit scanning.
+func (r Bip44Range) Expand(base Bip44) []Bip44 {
+	paths := make([]Bip44, 0, r.Count)
+	for i := uint32(0); i < r.Count; i++ {
+		p := base
+		if base.HardenedComponents >= 4 {
+			p.Account = base.Account + r.StartIndex + i
+		} else {
+			p.AddressIndex = base.AddressIndex + r.StartIndex + i
+		}
+		paths = append(paths, p)
+	}
+	return paths
+}
+
+// TypedDeriver is implemented by coins that can derive an account directly
+// from a structured Bip44 path, instead of a free-form path string.
+type TypedDeriver interface {
+	DeriveAccountTyped(seed []byte, p Bip44) (Account, error)
+}
+
 // OutputFormat specifies how wallet data should be formatted
 type OutputFormat int
 
@@ -97,6 +330,10 @@ const (
 	OutputText
 	OutputQR
 	OutputPaper
+	OutputKeystore
+	OutputWatchOnly
+	OutputShares
+	OutputPSBT
 )
 
 // OutputOptions controls what information is included in output
@@ -106,4 +343,38 @@ type OutputOptions struct {
 	IncludeMnemonic bool         `json:"include_mnemonic"`
 	IncludeQR       bool         `json:"include_qr"`
 	FilePath        string       `json:"file_path,omitempty"`
+
+	// KeystoreDir is the directory Web3 Secret Storage (V3) keystore files
+	// are written to when Format is OutputKeystore.
+	KeystoreDir string `json:"keystore_dir,omitempty"`
+	// KeystorePassphrase encrypts each keystore file; required for
+	// OutputKeystore.
+	KeystorePassphrase string `json:"-"`
+	// KeystoreAllowEd25519 permits emitting ed25519 (e.g. Solana) accounts in
+	// an Anvil-specific keystore variant instead of skipping them, since the
+	// V3 format itself is secp256k1-only.
+	KeystoreAllowEd25519 bool `json:"keystore_allow_ed25519,omitempty"`
+
+	// WatchOnlyFormat selects the import script flavor for OutputWatchOnly:
+	// "bitcoin-cli", "bitcoin-importwallet", or "descriptors".
+	WatchOnlyFormat string `json:"watch_only_format,omitempty"`
+	// RecoveryWindow is how many external/internal addresses to expand per
+	// BTC/DOGE account before emitting the watch-only import script.
+	RecoveryWindow int `json:"recovery_window,omitempty"`
+
+	// SeedFormat selects how the wallet's mnemonic is presented: "plain"
+	// (default, BIP39) or "aezeed" (an encrypted, passphrase-protected
+	// cipher seed; see internal/crypto.GenerateCipherSeed). Only takes
+	// effect when IncludeMnemonic is set.
+	SeedFormat string `json:"seed_format,omitempty"`
+	// SeedPassphrase encrypts the mnemonic when SeedFormat is "aezeed";
+	// required in that case.
+	SeedPassphrase string `json:"-"`
+
+	// ShareThreshold and ShareTotal configure Shamir secret sharing for
+	// Format OutputShares: ShareTotal shares are generated from the
+	// wallet's entropy, any ShareThreshold of which reconstruct it. See
+	// pkg/sss.
+	ShareThreshold int `json:"share_threshold,omitempty"`
+	ShareTotal     int `json:"share_total,omitempty"`
 }
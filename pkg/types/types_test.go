@@ -0,0 +1,73 @@
+package types
+
+import "testing"
+
+func TestBip44StringStandard(t *testing.T) {
+	p := Bip44{Coin: 60, Account: 0, Change: 0, AddressIndex: 1, HardenedComponents: 3}
+	if got, want := p.String(), "m/44'/60'/0'/0/1"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if p.ToPath() != p.String() {
+		t.Errorf("ToPath() and String() disagree")
+	}
+}
+
+func TestBip44StringSolana(t *testing.T) {
+	p := Bip44{Coin: 501, Account: 2, Change: 0, HardenedComponents: 4}
+	if got, want := p.String(), "m/44'/501'/2'/0'"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestParseBip44RoundTrip(t *testing.T) {
+	cases := []string{
+		"m/44'/0'/0'/0/0",
+		"m/44'/60'/1'/0/1",
+		"m/44'/195'/0'/0/0",
+		"m/44'/501'/0'/0'",
+	}
+	for _, path := range cases {
+		p, err := ParseBip44(path)
+		if err != nil {
+			t.Fatalf("ParseBip44(%q) returned error: %v", path, err)
+		}
+		if got := p.String(); got != path {
+			t.Errorf("ParseBip44(%q).String() = %q, want %q", path, got, path)
+		}
+	}
+}
+
+func TestParseBip44Invalid(t *testing.T) {
+	invalid := []string{
+		"m/49'/0'/0'/0/0",       // wrong purpose
+		"m/44'/0/0'/0/0",        // coin not hardened while account is
+		"m/44'/0'/0'/0",         // 4-component path without change hardened
+		"m/44'/0'/0'",           // too short
+		"m/44'/0'/0'/0/0/extra", // too long
+	}
+	for _, path := range invalid {
+		if _, err := ParseBip44(path); err == nil {
+			t.Errorf("ParseBip44(%q) expected error, got nil", path)
+		}
+	}
+}
+
+func TestBip44RangeExpand(t *testing.T) {
+	base := Bip44{Coin: 60, Account: 0, Change: 0, HardenedComponents: 3}
+	r := Bip44Range{StartIndex: 5, Count: 3}
+	paths := r.Expand(base)
+	if len(paths) != 3 {
+		t.Fatalf("expected 3 paths, got %d", len(paths))
+	}
+	for i, p := range paths {
+		if want := uint32(5 + i); p.AddressIndex != want {
+			t.Errorf("paths[%d].AddressIndex = %d, want %d", i, p.AddressIndex, want)
+		}
+	}
+
+	solanaBase := Bip44{Coin: 501, Account: 0, Change: 0, HardenedComponents: 4}
+	solanaPaths := Bip44Range{StartIndex: 0, Count: 2}.Expand(solanaBase)
+	if solanaPaths[0].Account != 0 || solanaPaths[1].Account != 1 {
+		t.Errorf("expected Solana range to vary Account, got %+v", solanaPaths)
+	}
+}
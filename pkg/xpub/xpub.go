@@ -0,0 +1,112 @@
+// Package xpub implements BIP32 extended-public-key encoding and parsing,
+// including the ypub/zpub version-byte variants SegWit wallets use to
+// advertise which address type an account-level key derives, without
+// requiring the importing tool to inspect the derivation path itself.
+package xpub
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+)
+
+// Version byte prefixes for BIP32 account-level extended public keys.
+// VersionXpub is the standard BIP32 public key version, used for legacy
+// P2PKH (BIP44) accounts and for coins (like Ethereum) with no address-type
+// variants at all. VersionYpub and VersionZpub are the de facto SegWit
+// extensions most wallets and block explorers recognize for BIP49
+// (P2SH-P2WPKH) and BIP84 (native P2WPKH) accounts respectively.
+const (
+	VersionXpub uint32 = 0x0488B21E
+	VersionYpub uint32 = 0x049D7CB2
+	VersionZpub uint32 = 0x04B24746
+)
+
+// VersionForPurpose returns the extended-key version byte conventionally
+// used for a BIP44-style purpose value (the first hardened path
+// component): 49 -> ypub, 84 -> zpub, anything else (44, 86, nonstandard)
+// -> the standard xpub version.
+func VersionForPurpose(purpose uint32) uint32 {
+	switch purpose {
+	case 49:
+		return VersionYpub
+	case 84:
+		return VersionZpub
+	default:
+		return VersionXpub
+	}
+}
+
+// Key is a parsed BIP32 extended public key.
+type Key struct {
+	Version     uint32
+	Depth       byte
+	FingerPrint uint32
+	ChildNumber uint32
+	ChainCode   [32]byte
+	PublicKey   [33]byte
+}
+
+// Encode serializes an account-level extended public key to its
+// Base58Check string form (xpub/ypub/zpub depending on version), per
+// BIP32: version || depth || fingerprint || child number || chain code ||
+// public key, followed by a 4-byte double-SHA256 checksum.
+func Encode(version uint32, depth byte, fingerprint, childNumber uint32, chainCode, publicKey []byte) (string, error) {
+	if len(chainCode) != 32 {
+		return "", fmt.Errorf("chain code must be 32 bytes, got %d", len(chainCode))
+	}
+	if len(publicKey) != 33 {
+		return "", fmt.Errorf("public key must be 33 bytes (compressed), got %d", len(publicKey))
+	}
+
+	buf := new(bytes.Buffer)
+	binary.Write(buf, binary.BigEndian, version)
+	buf.WriteByte(depth)
+	binary.Write(buf, binary.BigEndian, fingerprint)
+	binary.Write(buf, binary.BigEndian, childNumber)
+	buf.Write(chainCode)
+	buf.Write(publicKey)
+
+	return encodeWithChecksum(buf.Bytes()), nil
+}
+
+// encodeWithChecksum appends the BIP32 double-SHA256 checksum to payload
+// and Base58-encodes the result. BIP32 keys checksum the whole
+// version-through-pubkey payload the same way a Bitcoin address does, but
+// the version byte is already part of the payload rather than a separate
+// prefix, so base58.CheckEncode (which prepends its own single-byte
+// version) doesn't apply directly.
+func encodeWithChecksum(payload []byte) string {
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	return base58.Encode(append(payload, second[:4]...))
+}
+
+// Parse decodes a Base58Check-encoded extended public key (xpub, ypub,
+// zpub, or any other BIP32-compatible variant) back into its fields,
+// verifying the checksum.
+func Parse(encoded string) (Key, error) {
+	decoded := base58.Decode(encoded)
+	if len(decoded) != 82 {
+		return Key{}, fmt.Errorf("invalid extended public key length: %d", len(decoded))
+	}
+
+	payload, checksum := decoded[:78], decoded[78:]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(checksum, second[:4]) {
+		return Key{}, fmt.Errorf("invalid extended public key checksum")
+	}
+
+	var key Key
+	key.Version = binary.BigEndian.Uint32(payload[0:4])
+	key.Depth = payload[4]
+	key.FingerPrint = binary.BigEndian.Uint32(payload[5:9])
+	key.ChildNumber = binary.BigEndian.Uint32(payload[9:13])
+	copy(key.ChainCode[:], payload[13:45])
+	copy(key.PublicKey[:], payload[45:78])
+	return key, nil
+}
@@ -0,0 +1,99 @@
+package xpub
+
+import "testing"
+
+func TestEncodeParseRoundTrip(t *testing.T) {
+	chainCode := make([]byte, 32)
+	for i := range chainCode {
+		chainCode[i] = byte(i)
+	}
+	publicKey := make([]byte, 33)
+	publicKey[0] = 0x02
+	for i := 1; i < len(publicKey); i++ {
+		publicKey[i] = byte(i * 3)
+	}
+
+	encoded, err := Encode(VersionZpub, 3, 0xAABBCCDD, 0x80000000, chainCode, publicKey)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	key, err := Parse(encoded)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if key.Version != VersionZpub {
+		t.Errorf("Version = %#x, want %#x", key.Version, VersionZpub)
+	}
+	if key.Depth != 3 {
+		t.Errorf("Depth = %d, want 3", key.Depth)
+	}
+	if key.FingerPrint != 0xAABBCCDD {
+		t.Errorf("FingerPrint = %#x, want %#x", key.FingerPrint, 0xAABBCCDD)
+	}
+	if key.ChildNumber != 0x80000000 {
+		t.Errorf("ChildNumber = %#x, want %#x", key.ChildNumber, 0x80000000)
+	}
+	var wantChainCode [32]byte
+	copy(wantChainCode[:], chainCode)
+	if key.ChainCode != wantChainCode {
+		t.Errorf("ChainCode mismatch")
+	}
+
+	var wantPublicKey [33]byte
+	copy(wantPublicKey[:], publicKey)
+	if key.PublicKey != wantPublicKey {
+		t.Errorf("PublicKey mismatch")
+	}
+}
+
+func TestParseRejectsBadChecksum(t *testing.T) {
+	chainCode := make([]byte, 32)
+	publicKey := make([]byte, 33)
+	publicKey[0] = 0x03
+
+	encoded, err := Encode(VersionXpub, 0, 0, 0, chainCode, publicKey)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	corrupted := []rune(encoded)
+	if corrupted[0] == 'x' {
+		corrupted[0] = 'y'
+	} else {
+		corrupted[0] = 'x'
+	}
+
+	if _, err := Parse(string(corrupted)); err == nil {
+		t.Error("expected a corrupted extended key to fail parsing")
+	}
+}
+
+func TestVersionForPurpose(t *testing.T) {
+	tests := []struct {
+		purpose uint32
+		want    uint32
+	}{
+		{44, VersionXpub},
+		{49, VersionYpub},
+		{84, VersionZpub},
+		{86, VersionXpub},
+		{0, VersionXpub},
+	}
+
+	for _, tc := range tests {
+		if got := VersionForPurpose(tc.purpose); got != tc.want {
+			t.Errorf("VersionForPurpose(%d) = %#x, want %#x", tc.purpose, got, tc.want)
+		}
+	}
+}
+
+func TestEncodeRejectsWrongLengths(t *testing.T) {
+	if _, err := Encode(VersionXpub, 0, 0, 0, make([]byte, 31), make([]byte, 33)); err == nil {
+		t.Error("expected an error for a short chain code")
+	}
+	if _, err := Encode(VersionXpub, 0, 0, 0, make([]byte, 32), make([]byte, 32)); err == nil {
+		t.Error("expected an error for an uncompressed/short public key")
+	}
+}